@@ -5,13 +5,32 @@ type breakKind uint8
 const (
 	breakOpportunity breakKind = iota + 1
 	breakMandatory
+	// breakEmergency splits a segment which has no opportunity, see
+	// [Iterator.Overflow]
+	breakEmergency
+	// breakHyphenated is an opportunity after U+00AD SOFT HYPHEN
+	breakHyphenated
 )
 
 func (p property) is(classes property) bool {
 	return (p & classes) != 0
 }
 
+// zeroOptions are the options of NextBreak, the default UAX #14 rules,
+// which, unlike [DefaultOptions], callers cannot change.
+var zeroOptions Options
+
+// NextBreak returns the length of the first line break segment of data, and
+// whether the break at its end is mandatory or an opportunity.
 func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
+	return nextBreak(data, &zeroOptions, nil)
+}
+
+// nextBreak is NextBreak with options, and constraints c, which may be nil.
+func nextBreak[T ~string | ~[]byte](data T, options *Options, c *constraints) (advance int, kind breakKind) {
+	if options.ruleset != nil {
+		return nextBreakRuleset(data, options, c)
+	}
 	if len(data) == 0 {
 		return 0, breakMandatory
 	}
@@ -21,12 +40,23 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 	var lastExSP property          // "last excluding SP"
 	var beforeLastExSP property    // predecessor of lastExSP, with CM/ZWJ ignored
 	var lastExCMZWJ property       // "last excluding CM and ZWJ"
+	var beforeLastExCMZWJ property // predecessor of lastExCMZWJ
 	var lastExCMZWJSP property     // "last excluding CM and ZWJ and SP"
 	var lastExSYIS property        // "last excluding SY and IS", with CM/ZWJ ignored
 	var beforeLastExSYIS property  // predecessor of lastExSYIS
 	var regionalIndicatorCount int // count of consecutive RI (excluding CM/ZWJ)
-
-	current, w := lookupProperty(data[pos:])
+	clusters := graphemeCursor[T]{data: data}
+
+	// Without overrides, the trie is looked up directly, so that the
+	// default path costs nothing for them
+	ov := options.overrides
+	var current property
+	var w int
+	if ov == nil {
+		current, w = lookupProperty(data[pos:])
+	} else {
+		current, w = lookupPropertyWith(data[pos:], ov)
+	}
 	if w == 0 {
 		pos = len(data)
 		return pos, breakMandatory
@@ -52,12 +82,18 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 			lastExSP = last
 		}
 		if !last.is(_CM | _ZWJ) {
+			beforeLastExCMZWJ = lastExCMZWJ
 			lastExCMZWJ = last
 			if last.is(_RI) {
 				regionalIndicatorCount++
 			} else {
 				regionalIndicatorCount = 0
 			}
+		} else if lastExCMZWJ == 0 {
+			// https://www.unicode.org/reports/tr14/#LB10
+			// CM and ZWJ at sot were not absorbed, and resolve to AL
+			lastExCMZWJ = _AL | last&_EA
+			lastExCMZWJSP = lastExCMZWJ
 		}
 		if !last.is(_SP | _CM | _ZWJ) {
 			lastExCMZWJSP = last
@@ -67,7 +103,11 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 			lastExSYIS = lastExCMZWJ
 		}
 
-		current, w = lookup(data[pos:])
+		if ov == nil {
+			current, w = lookup(data[pos:])
+		} else {
+			current, w = lookupWith(data[pos:], ov)
+		}
 		if w == 0 {
 			pos = len(data)
 			return pos, breakMandatory
@@ -76,6 +116,14 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 			current = _AL
 		}
 
+		// https://www.unicode.org/reports/tr14/#LB10
+		// CM and ZWJ after BK/CR/LF/NL/SP/ZW are not absorbed (LB9), and
+		// resolve to AL. Resolved ahead of the rules, so that state does not
+		// depend on which rule decides.
+		if current.is(_CM|_ZWJ) && lastExCMZWJ.is(_BK|_CR|_LF|_NL|_SP|_ZW) {
+			current = _AL | current&_EA
+		}
+
 		// https://www.unicode.org/reports/tr14/#LB4
 		// Break after BK
 		if last.is(_BK) {
@@ -99,6 +147,32 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 			continue
 		}
 
+		// Caller constraints, see [Iterator.SetConstraints]
+		if c.noBreakAt(pos) {
+			pos += w
+			continue
+		}
+		if c.allowBreakAt(pos) {
+			return pos, breakOpportunity
+		}
+
+		// Grapheme cluster boundaries, see [Options.WithGraphemeBoundaries]
+		if options.graphemes && !clusters.isBoundary(pos) {
+			pos += w
+			continue
+		}
+
+		// Custom rules, see [Options.WithRule]
+		if rules := options.rules[BeforeLB7]; len(rules) > 0 {
+			switch decide(rules, ov, data, pos, w, last, lastExSP, lastExCMZWJ, lastExCMZWJSP, current) {
+			case Break:
+				return pos, breakOpportunity
+			case NoBreak:
+				pos += w
+				continue
+			}
+		}
+
 		// https://www.unicode.org/reports/tr14/#LB7
 		// No break before SP or ZW
 		if current.is(_SP | _ZW) {
@@ -112,6 +186,12 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 			return pos, breakOpportunity
 		}
 
+		// French spacing, see [Options.WithFrenchSpacing]
+		if last.is(_SP) && ov != nil && frenchSpaceAt(data, pos, ov) {
+			pos += w
+			continue
+		}
+
 		// https://www.unicode.org/reports/tr14/#LB8a
 		// No break after ZWJ
 		if last.is(_ZWJ) {
@@ -120,16 +200,11 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 		}
 
 		// https://www.unicode.org/reports/tr14/#LB9
-		// Absorb CM and ZWJ into the preceding base character (if eligible)
-
-		// https://www.unicode.org/reports/tr14/#LB10
-		// Remaining CM and ZWJ (after BK/CR/LF/NL/SP/ZW or sot) resolve to AL
+		// Absorb CM and ZWJ into the preceding base character; those which
+		// are not absorbed were resolved to AL above (LB10)
 		if current.is(_CM | _ZWJ) {
-			if lastExCMZWJ != 0 && !lastExCMZWJ.is(_BK|_CR|_LF|_NL|_SP|_ZW) {
-				pos += w
-				continue
-			}
-			current = _AL
+			pos += w
+			continue
 		}
 
 		// https://www.unicode.org/reports/tr14/#LB11
@@ -178,10 +253,7 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 		// https://www.unicode.org/reports/tr14/#LB15b
 		// × [\p{Pf}&QU] (SP | GL | WJ | CL | QU | CP | EX | IS | SY | BK | CR | LF | NL | ZW | eot)
 		if current.is(_PF) && current.is(_QU) {
-			var next property
-			if pos+w < len(data) {
-				next, _ = lookupProperty(data[pos+w:])
-			}
+			next := nextExCMZWJ(data[pos+w:], ov)
 			if next == 0 || next.is(_SP|_GL|_WJ|_CL|_QU|_CP|_EX|_IS|_SY|_BK|_CR|_LF|_NL|_ZW) {
 				pos += w
 				continue
@@ -193,7 +265,7 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 		if last.is(_SP) && current.is(_IS) {
 			var next property
 			if pos+w < len(data) {
-				next, _ = lookupProperty(data[pos+w:])
+				next, _ = lookupPropertyWith(data[pos+w:], ov)
 			}
 			if next.is(_NU) {
 				return pos, breakOpportunity
@@ -221,6 +293,23 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 			continue
 		}
 
+		// Custom rules, see [Options.WithRule]
+		if rules := options.rules[BeforeLB18]; len(rules) > 0 {
+			switch decide(rules, ov, data, pos, w, last, lastExSP, lastExCMZWJ, lastExCMZWJSP, current) {
+			case Break:
+				return pos, breakOpportunity
+			case NoBreak:
+				pos += w
+				continue
+			}
+		}
+
+		// Single-letter words, see [Options.WithSingleLetterWords]
+		if last.is(_SP) && ov != nil && afterSingleLetter(data, pos, ov, c) {
+			pos += w
+			continue
+		}
+
 		// https://www.unicode.org/reports/tr14/#LB18
 		// SP ÷
 		if last.is(_SP) {
@@ -239,14 +328,11 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 		// × QU ( [^$EastAsian] | eot )
 		// QU × [^$EastAsian]
 		// ( sot | [^$EastAsian] ) QU ×
-		if current.is(_QU) || last.is(_QU) {
-			var next property
-			if pos+w < len(data) {
-				next, _ = lookupProperty(data[pos+w:])
-			}
+		if current.is(_QU) || lastExCMZWJ.is(_QU) {
+			next := nextExCMZWJ(data[pos+w:], ov)
 
-			noBreakBeforeQU := current.is(_QU) && (!last.is(_EA) || !next.is(_EA))
-			noBreakAfterQU := last.is(_QU) && (!current.is(_EA) || prevExCMZWJ == 0 || !prevExCMZWJ.is(_EA))
+			noBreakBeforeQU := current.is(_QU) && (!lastExCMZWJ.is(_EA) || !next.is(_EA))
+			noBreakAfterQU := lastExCMZWJ.is(_QU) && (!current.is(_EA) || beforeLastExCMZWJ == 0 || !beforeLastExCMZWJ.is(_EA))
 			if noBreakBeforeQU || noBreakAfterQU {
 				pos += w
 				continue
@@ -261,8 +347,8 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 
 		// https://www.unicode.org/reports/tr14/#LB20a
 		// (sot | BK | CR | LF | NL | SP | ZW | CB | GL) (HY | HH) × (AL | HL)
-		if last.is(_HY|_HH) && current.is(_AL|_HL) &&
-			(prevExCMZWJ == 0 || prevExCMZWJ.is(_BK|_CR|_LF|_NL|_SP|_ZW|_CB|_GL)) {
+		if lastExCMZWJ.is(_HY|_HH) && current.is(_AL|_HL) &&
+			(beforeLastExCMZWJ == 0 || beforeLastExCMZWJ.is(_BK|_CR|_LF|_NL|_SP|_ZW|_CB|_GL)) {
 			pos += w
 			continue
 		}
@@ -280,7 +366,7 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 
 		// https://www.unicode.org/reports/tr14/#LB21a
 		// HL (HY | HH) × [^HL]
-		if prevExCMZWJ.is(_HL) && last.is(_HY|_HH) && !current.is(_HL) {
+		if beforeLastExCMZWJ.is(_HL) && lastExCMZWJ.is(_HY|_HH) && !current.is(_HL) {
 			pos += w
 			continue
 		}
@@ -355,9 +441,9 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 		if lastExCMZWJ.is(_PO|_PR) && current.is(_OP) {
 			var next, next2 property
 			if pos+w < len(data) {
-				next, _ = lookupProperty(data[pos+w:])
+				next, _ = lookupPropertyWith(data[pos+w:], ov)
 				if pos+w+w < len(data) {
-					next2, _ = lookupProperty(data[pos+w+w:])
+					next2, _ = lookupPropertyWith(data[pos+w+w:], ov)
 				}
 			}
 			if next.is(_NU) || (next.is(_IS) && next2.is(_NU)) {
@@ -406,13 +492,13 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 		// (AK | [◌] | AS) × (AK | [◌] | AS) VF
 		if (lastExCMZWJ.is(_AP) && current.is(_AK|_AS|_DC)) ||
 			(lastExCMZWJ.is(_AK|_AS|_DC) && current.is(_VF|_VI)) ||
-			(lastExCMZWJ.is(_VI) && current.is(_AK|_AS|_DC) && prevExCMZWJ.is(_AK|_AS|_DC)) {
+			(lastExCMZWJ.is(_VI) && current.is(_AK|_AS|_DC) && beforeLastExCMZWJ.is(_AK|_AS|_DC)) {
 			pos += w
 			continue
 		}
 		if lastExCMZWJ.is(_AK|_AS|_DC) && current.is(_AK|_AS|_DC) {
 			if pos+w < len(data) {
-				next, _ := lookupProperty(data[pos+w:])
+				next, _ := lookupPropertyWith(data[pos+w:], ov)
 				if next.is(_VF) {
 					pos += w
 					continue
@@ -455,9 +541,40 @@ func NextBreak[T ~string | ~[]byte](data T) (advance int, kind breakKind) {
 			continue
 		}
 
+		// Custom rules, see [Options.WithRule]
+		if rules := options.rules[BeforeLB31]; len(rules) > 0 {
+			switch decide(rules, ov, data, pos, w, last, lastExSP, lastExCMZWJ, lastExCMZWJSP, current) {
+			case Break:
+				return pos, breakOpportunity
+			case NoBreak:
+				pos += w
+				continue
+			}
+		}
+
+		// Word break tailoring, see [WordBreakKeepAll] and [WordBreakKorean]
+		if ov != nil && ov.keeps(lastExCMZWJ, current) {
+			pos += w
+			continue
+		}
+
 		// https://www.unicode.org/reports/tr14/#LB31
 		// ALL ÷
 		// ÷ ALL
 		return pos, breakOpportunity
 	}
 }
+
+// nextExCMZWJ returns the property of the first character of data which is
+// not CM or ZWJ, as those take the class of the character before them
+// (LB9), or 0 at eot.
+func nextExCMZWJ[T ~string | ~[]byte](data T, ov *overrides) property {
+	for len(data) > 0 {
+		p, w := lookupPropertyWith(data, ov)
+		if w == 0 || !p.is(_CM|_ZWJ) {
+			return p
+		}
+		data = data[w:]
+	}
+	return 0
+}
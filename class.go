@@ -0,0 +1,63 @@
+package uax14
+
+// Class is a set of UAX #14 line breaking classes, as bit flags.
+//
+// Classes are resolved per LB1: AI, SG and XX appear as AL, CJ as NS,
+// and SA as CM or AL. A Class may carry additional internal bits (such as
+// East Asian width), so test membership with [Class.Is] rather than ==.
+//
+// See https://www.unicode.org/reports/tr14/#Table1.
+type Class uint64
+
+// Is determines if c intersects the given class(es).
+func (c Class) Is(classes Class) bool {
+	return (c & classes) != 0
+}
+
+// Line breaking classes. Combine them with | to form sets.
+const (
+	AK  = Class(_AK)
+	AL  = Class(_AL)
+	AP  = Class(_AP)
+	AS  = Class(_AS)
+	B2  = Class(_B2)
+	BA  = Class(_BA)
+	BB  = Class(_BB)
+	BK  = Class(_BK)
+	CB  = Class(_CB)
+	CL  = Class(_CL)
+	CM  = Class(_CM)
+	CP  = Class(_CP)
+	CR  = Class(_CR)
+	EB  = Class(_EB)
+	EM  = Class(_EM)
+	EX  = Class(_EX)
+	GL  = Class(_GL)
+	H2  = Class(_H2)
+	H3  = Class(_H3)
+	HH  = Class(_HH)
+	HL  = Class(_HL)
+	HY  = Class(_HY)
+	ID  = Class(_ID)
+	IN  = Class(_IN)
+	IS  = Class(_IS)
+	JL  = Class(_JL)
+	JT  = Class(_JT)
+	JV  = Class(_JV)
+	LF  = Class(_LF)
+	NL  = Class(_NL)
+	NS  = Class(_NS)
+	NU  = Class(_NU)
+	OP  = Class(_OP)
+	PO  = Class(_PO)
+	PR  = Class(_PR)
+	QU  = Class(_QU)
+	RI  = Class(_RI)
+	SP  = Class(_SP)
+	SY  = Class(_SY)
+	VF  = Class(_VF)
+	VI  = Class(_VI)
+	WJ  = Class(_WJ)
+	ZW  = Class(_ZW)
+	ZWJ = Class(_ZWJ)
+)
@@ -0,0 +1,188 @@
+package uax14
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ComplexContextSegmenter finds word boundaries within runs of complex
+// context scripts (class SA), such as Thai, Lao, Khmer and Myanmar, which
+// are written without spaces between words. By default, SA characters are
+// treated as AL (or CM), so there are no break opportunities within a run.
+//
+// Use [Options.WithComplexContextSegmenter] to add the boundaries found by a
+// segmenter to the break opportunities of an [Iterator].
+type ComplexContextSegmenter interface {
+	// Segment returns the word boundaries within run, a maximal run of SA
+	// characters, as byte offsets in increasing order, excluding 0 and
+	// len(run). Offsets which are not between grapheme clusters are ignored.
+	Segment(run string) []int
+}
+
+// WithComplexContextSegmenter returns a copy of options, which adds the word
+// boundaries found by s within runs of SA characters to the break
+// opportunities of an [Iterator]. A nil s restores the default, with no
+// opportunities within a run.
+func (options Options) WithComplexContextSegmenter(s ComplexContextSegmenter) Options {
+	options.complex = s
+	return options
+}
+
+// overridingSegmenter is a [ComplexContextSegmenter] which consults the
+// class overrides of the options it is used with.
+type overridingSegmenter interface {
+	segmentWith(run string, ov *overrides) []int
+}
+
+// segmentWith returns the word boundaries within run, found by s, with the
+// class overrides ov if s consults them.
+func segmentWith(s ComplexContextSegmenter, run string, ov *overrides) []int {
+	if o, ok := s.(overridingSegmenter); ok {
+		return o.segmentWith(run, ov)
+	}
+	return s.Segment(run)
+}
+
+// complexBreaks returns the word boundaries within the runs of SA characters
+// of segment, as byte offsets in increasing order.
+func complexBreaks[T ~string | ~[]byte](segment T, options *Options) []int {
+	var breaks []int
+	start := -1 // start of the current run, if any
+	pos := 0
+	for {
+		var p property
+		var w int
+		if pos < len(segment) {
+			p, w = lookupPropertyWith(segment[pos:], options.overrides)
+		}
+		inRun := w > 0 && p.is(_SA)
+		if !inRun && start >= 0 {
+			run := segment[start:pos]
+			last := 0
+			for _, b := range segmentWith(options.complex, string(run), options.overrides) {
+				if b <= last || b >= len(run) {
+					continue
+				}
+				// Never break within a grapheme cluster
+				if q, _ := lookupPropertyWith(run[b:], options.overrides); q.is(_CM | _ZWJ) {
+					continue
+				}
+				breaks = append(breaks, start+b)
+				last = b
+			}
+			start = -1
+		}
+		if w == 0 {
+			return breaks
+		}
+		if inRun && start < 0 {
+			start = pos
+		}
+		pos += w
+	}
+}
+
+// DictionarySegmenter is a [ComplexContextSegmenter] which divides a run into
+// words from a word list, by maximal matching: the division with the fewest
+// unknown characters, and then the fewest words. A DictionarySegmenter is
+// immutable, and safe to share across goroutines.
+type DictionarySegmenter struct {
+	words map[string]struct{}
+	// longest is the length of the longest word, in bytes
+	longest int
+}
+
+// NewDictionarySegmenter reads a word list, one word per line, such as the
+// Thai, Lao or Khmer lists of ICU or LibreOffice. Leading and trailing white
+// space and blank lines are ignored, as is anything after # on a line.
+func NewDictionarySegmenter(words io.Reader) (*DictionarySegmenter, error) {
+	d := &DictionarySegmenter{words: map[string]struct{}{}}
+	scanner := bufio.NewScanner(words)
+	for scanner.Scan() {
+		word, _, _ := strings.Cut(scanner.Text(), "#")
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		d.words[word] = struct{}{}
+		d.longest = max(d.longest, len(word))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Segment implements [ComplexContextSegmenter].
+func (d *DictionarySegmenter) Segment(run string) []int {
+	// cost of the best division of run[:i], at each rune boundary i
+	type cost struct {
+		unknown int // unknown grapheme clusters
+		words   int
+		// from is the start of the last word, or of the last cluster if it
+		// is unknown
+		from    int
+		known   bool
+		reached bool
+	}
+	better := func(a, b cost) bool {
+		return !b.reached || a.unknown < b.unknown || a.unknown == b.unknown && a.words < b.words
+	}
+
+	costs := make([]cost, len(run)+1)
+	costs[0].reached = true
+	for i := 0; i < len(run); {
+		c := costs[i]
+		if !c.reached {
+			_, w := utf8.DecodeRuneInString(run[i:])
+			i += w
+			continue
+		}
+
+		// Known words starting at i
+		for j := i + 1; j <= len(run) && j-i <= d.longest; j++ {
+			if j < len(run) && !utf8.RuneStart(run[j]) {
+				continue
+			}
+			if _, ok := d.words[run[i:j]]; ok {
+				next := cost{unknown: c.unknown, words: c.words + 1, from: i, known: true, reached: true}
+				if better(next, costs[j]) {
+					costs[j] = next
+				}
+			}
+		}
+
+		// An unknown grapheme cluster, which continues an unknown word
+		j := i + nextGrapheme(run[i:])
+		next := cost{unknown: c.unknown + 1, words: c.words + 1, from: i, reached: true}
+		if i > 0 && !c.known {
+			next.words = c.words
+		}
+		if better(next, costs[j]) {
+			costs[j] = next
+		}
+
+		_, w := utf8.DecodeRuneInString(run[i:])
+		i += w
+	}
+
+	// Walk back from the end, merging unknown clusters into one word
+	var breaks []int
+	for i := len(run); i > 0; {
+		c := costs[i]
+		i = c.from
+		if !c.known {
+			for i > 0 && !costs[i].known && costs[i].reached {
+				i = costs[i].from
+			}
+		}
+		if i > 0 {
+			breaks = append(breaks, i)
+		}
+	}
+	slices.Reverse(breaks)
+	return breaks
+}
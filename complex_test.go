@@ -0,0 +1,104 @@
+package uax14
+
+import (
+	"strings"
+	"testing"
+)
+
+// thaiWords is a tiny Thai word list, for tests
+const thaiWords = `# Thai
+ฉัน
+กิน
+ข้าว
+ผัด
+ข้าวผัด
+อร่อย
+มาก
+`
+
+func TestDictionarySegmenter(t *testing.T) {
+	d, err := NewDictionarySegmenter(strings.NewReader(thaiWords))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		run  string
+		want []string
+	}{
+		{"ฉันกินข้าว", []string{"ฉัน", "กิน", "ข้าว"}},
+		// Longest match
+		{"ฉันกินข้าวผัด", []string{"ฉัน", "กิน", "ข้าวผัด"}},
+		// Maximal matching: อร่อย and มาก, not อร่อ and ยมาก
+		{"อร่อยมาก", []string{"อร่อย", "มาก"}},
+		// Unknown words are kept together
+		{"ฉันชอบกิน", []string{"ฉัน", "ชอบ", "กิน"}},
+		{"สวัสดี", []string{"สวัสดี"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got := split(tt.run, d.Segment(tt.run))
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("Segment(%q) = %q, want %q", tt.run, got, tt.want)
+		}
+	}
+}
+
+func TestIterator_ComplexContextSegmenter(t *testing.T) {
+	d, err := NewDictionarySegmenter(strings.NewReader(thaiWords))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		options Options
+		want    []string
+	}{
+		{
+			name:    "default",
+			in:      "ฉันกินข้าว อร่อยมาก",
+			options: DefaultOptions,
+			want:    []string{"ฉันกินข้าว ", "อร่อยมาก"},
+		},
+		{
+			name:    "dictionary",
+			in:      "ฉันกินข้าว อร่อยมาก",
+			options: DefaultOptions.WithComplexContextSegmenter(d),
+			want:    []string{"ฉัน", "กิน", "ข้าว ", "อร่อย", "มาก"},
+		},
+		{
+			name:    "mixed scripts",
+			in:      "(ฉันกินข้าว)abc",
+			options: DefaultOptions.WithComplexContextSegmenter(d),
+			want:    []string{"(ฉัน", "กิน", "ข้าว)abc"},
+		},
+		{
+			name: "within grapheme clusters",
+			in:   "กินข้าว",
+			options: DefaultOptions.WithComplexContextSegmenter(segmenterFunc(func(run string) []int {
+				// Before the mark of ข้
+				return []int{len("กินข"), len("กินข้")}
+			})),
+			want: []string{"กินข้", "าว"},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{tt.options, tt.options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				got := segments(tt.in, options)
+				if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+					t.Fatalf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+type segmenterFunc func(run string) []int
+
+func (f segmenterFunc) Segment(run string) []int {
+	return f(run)
+}
@@ -0,0 +1,161 @@
+package uax14
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// Span is a range of byte positions, from Start up to but not including End.
+type Span struct {
+	Start, End int
+}
+
+// constraints are breaks prohibited or allowed by the caller, see
+// [Iterator.SetConstraints], and within technical text, see
+// [Options.WithTechnical].
+type constraints struct {
+	// noBreak are sorted and non-overlapping
+	noBreak []Span
+	// allowBreak are sorted and unique
+	allowBreak []int
+	// technical reports breaks within technical text, if not nil
+	technical func(pos int) (held, allowed bool)
+	// base is the position in the text of the data being broken
+	base int
+	// midWord is set where the data being broken continues a word of the
+	// text before it, so that a single-letter word at its start does not
+	// stand alone, see [Options.WithSingleLetterWords]
+	midWord bool
+}
+
+// empty reports whether c has no effect.
+func (c *constraints) empty() bool {
+	return len(c.noBreak) == 0 && len(c.allowBreak) == 0 && c.technical == nil
+}
+
+// SetConstraints sets breaks which the caller prohibits or allows, such as
+// for names, product codes or prices which must not be split, or positions
+// within words where a break is wanted, and resets the iterator to the
+// beginning of the data. Constraints apply until the next call to
+// SetConstraints or SetText; nil clears them.
+//
+// There is no break within a span of noBreak, that is, after its Start and
+// before its End, other than a mandatory break, such as after a line
+// terminator. At each offset of allowBreak there is a break opportunity,
+// other than within a span of noBreak, or before a line terminator.
+// Constraints take precedence over the rules, including custom rules, a
+// [Ruleset], and [Options.WithGraphemeBoundaries], and over breaks added by
+// a [Hyphenator] or [ComplexContextSegmenter], or by [Iterator.Overflow].
+//
+// Spans must be sorted by Start, and not overlap; offsets must be sorted,
+// and unique. SetConstraints returns an error, leaving the constraints
+// unchanged, if they are not, if a position is outside the data, or if a
+// position is within a multi-byte UTF-8 sequence.
+func (iter *Iterator[T]) SetConstraints(noBreak []Span, allowBreak []int) error {
+	for i, span := range noBreak {
+		if span.Start > span.End {
+			return fmt.Errorf("no-break span %d: start %d is after end %d", i, span.Start, span.End)
+		}
+		if i > 0 && span.Start < noBreak[i-1].End {
+			return fmt.Errorf("no-break span %d: [%d, %d) is not after span %d, [%d, %d)", i, span.Start, span.End, i-1, noBreak[i-1].Start, noBreak[i-1].End)
+		}
+		for _, pos := range [2]int{span.Start, span.End} {
+			if err := checkPosition(iter.data, pos); err != nil {
+				return fmt.Errorf("no-break span %d: %w", i, err)
+			}
+		}
+	}
+	for i, pos := range allowBreak {
+		if i > 0 && pos <= allowBreak[i-1] {
+			return fmt.Errorf("allow-break offset %d: %d is not after %d", i, pos, allowBreak[i-1])
+		}
+		if err := checkPosition(iter.data, pos); err != nil {
+			return fmt.Errorf("allow-break offset %d: %w", i, err)
+		}
+	}
+
+	iter.constraints.noBreak = noBreak
+	iter.constraints.allowBreak = allowBreak
+	iter.Reset()
+	return nil
+}
+
+// checkPosition returns an error if pos is outside data, or within a
+// multi-byte UTF-8 sequence.
+func checkPosition[T ~string | ~[]byte](data T, pos int) error {
+	if pos < 0 || pos > len(data) {
+		return fmt.Errorf("position %d is outside the text, of length %d", pos, len(data))
+	}
+	if pos == len(data) {
+		return nil
+	}
+	// The start of the sequence which may contain pos
+	start := pos
+	for start > 0 && pos-start < utf8.UTFMax-1 && !utf8.RuneStart(data[start]) {
+		start--
+	}
+	if start < pos {
+		var buf [utf8.UTFMax]byte
+		n := copy(buf[:], data[start:])
+		if _, size := utf8.DecodeRune(buf[:n]); start+size > pos {
+			return fmt.Errorf("position %d is within a multi-byte sequence, which starts at %d", pos, start)
+		}
+	}
+	return nil
+}
+
+// noBreakAt reports whether a break at pos, relative to c.base, is
+// prohibited.
+func (c *constraints) noBreakAt(pos int) bool {
+	if c == nil {
+		return false
+	}
+	return c.holds(c.base + pos)
+}
+
+// allowBreakAt reports whether a break at pos, relative to c.base, is
+// allowed. It is only meaningful where noBreakAt is false.
+func (c *constraints) allowBreakAt(pos int) bool {
+	if c == nil {
+		return false
+	}
+	return c.allows(c.base + pos)
+}
+
+// holds reports whether a break at pos is prohibited: within a span of
+// c.noBreak, or within technical text, other than at an offset of
+// c.allowBreak.
+func (c *constraints) holds(pos int) bool {
+	if c == nil {
+		return false
+	}
+	// The first span ending after pos
+	i := sort.Search(len(c.noBreak), func(i int) bool { return c.noBreak[i].End > pos })
+	if i < len(c.noBreak) && c.noBreak[i].Start < pos {
+		return true
+	}
+	if c.technical != nil {
+		held, _ := c.technical(pos)
+		return held && !c.allowedByCaller(pos)
+	}
+	return false
+}
+
+// allows reports whether a break at pos is allowed, by the caller or within
+// technical text.
+func (c *constraints) allows(pos int) bool {
+	if c.allowedByCaller(pos) {
+		return true
+	}
+	if c.technical != nil {
+		_, allowed := c.technical(pos)
+		return allowed
+	}
+	return false
+}
+
+func (c *constraints) allowedByCaller(pos int) bool {
+	i := sort.SearchInts(c.allowBreak, pos)
+	return i < len(c.allowBreak) && c.allowBreak[i] == pos
+}
@@ -0,0 +1,152 @@
+package uax14
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIterator_SetConstraints(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         string
+		noBreak    []Span
+		allowBreak []int
+		options    Options
+		want       []string
+	}{
+		{
+			name:    "no-break span",
+			in:      "Dr. Jane Smith paid $ 10 000",
+			noBreak: []Span{{0, 14}, {20, 28}},
+			want:    []string{"Dr. Jane Smith ", "paid ", "$ 10 000"},
+		},
+		{
+			name:    "span ends are breaks",
+			in:      "one two three",
+			noBreak: []Span{{4, 8}},
+			want:    []string{"one ", "two ", "three"},
+		},
+		{
+			name:    "mandatory breaks are kept",
+			in:      "one\ntwo three",
+			noBreak: []Span{{0, 13}},
+			want:    []string{"one\n", "two three"},
+		},
+		{
+			name:       "allow-break offsets",
+			in:         "ProductCode-12345",
+			allowBreak: []int{7, 12},
+			want:       []string{"Product", "Code-", "12345"},
+		},
+		{
+			name:       "not before a line terminator",
+			in:         "ab\ncd",
+			allowBreak: []int{1, 2},
+			want:       []string{"a", "b\n", "cd"},
+		},
+		{
+			name:       "no-break wins",
+			in:         "abc def",
+			noBreak:    []Span{{0, 7}},
+			allowBreak: []int{1},
+			want:       []string{"abc def"},
+		},
+		{
+			name:       "within grapheme clusters",
+			in:         "e\u0301e\u0301",
+			allowBreak: []int{1},
+			options:    DefaultOptions.WithGraphemeBoundaries(true),
+			want:       []string{"e", "\u0301e\u0301"},
+		},
+		{
+			name:    "break-all",
+			in:      "한국어 문장",
+			noBreak: []Span{{0, len("한국어 문장")}},
+			options: DefaultOptions.WithWordBreak(WordBreakBreakAll),
+			want:    []string{"한국어 문장"},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{tt.options, tt.options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				iter := NewIterator(tt.in)
+				iter.SetOptions(options)
+				if err := iter.SetConstraints(tt.noBreak, tt.allowBreak); err != nil {
+					t.Fatal(err)
+				}
+				var got []string
+				for iter.Next() {
+					got = append(got, iter.Current())
+				}
+				if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+					t.Fatalf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestIterator_SetConstraintsInner(t *testing.T) {
+	// Hyphenation points and emergency breaks honor no-break spans
+	h := loadHyphenator(t)
+	in := "hyphenation hyphenation"
+	iter := NewIterator(in)
+	iter.SetOptions(DefaultOptions.WithHyphenator(h))
+	if err := iter.SetConstraints([]Span{{0, 11}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for iter.Next() {
+		got = append(got, iter.Current())
+	}
+	want := []string{"hyphenation ", "hy", "phen", "ation"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	iter = NewIterator("0123456789")
+	if err := iter.SetConstraints([]Span{{2, 6}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	got = got[:0]
+	for iter.Next() {
+		iter.Overflow(func(s string) bool { return len(s) <= 4 })
+		got = append(got, iter.Current())
+	}
+	want = []string{"01", "2345", "6789"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestIterator_SetConstraintsErrors(t *testing.T) {
+	in := "añb" // ñ is 2 bytes
+	tests := []struct {
+		name       string
+		noBreak    []Span
+		allowBreak []int
+		want       string
+	}{
+		{"within a sequence", nil, []int{2}, "within a multi-byte sequence"},
+		{"span within a sequence", []Span{{0, 2}}, nil, "within a multi-byte sequence"},
+		{"outside", nil, []int{5}, "outside the text"},
+		{"negative", []Span{{-1, 1}}, nil, "outside the text"},
+		{"reversed span", []Span{{3, 1}}, nil, "is after end"},
+		{"overlapping spans", []Span{{0, 3}, {1, 4}}, nil, "is not after"},
+		{"unsorted offsets", nil, []int{3, 1}, "is not after"},
+		{"duplicate offsets", nil, []int{1, 1}, "is not after"},
+	}
+	for _, tt := range tests {
+		iter := NewIterator(in)
+		err := iter.SetConstraints(tt.noBreak, tt.allowBreak)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.want)
+		}
+	}
+
+	iter := NewIterator(in)
+	if err := iter.SetConstraints([]Span{{0, 1}, {1, 3}, {3, 4}}, []int{0, 1, 3, 4}); err != nil {
+		t.Errorf("valid constraints: %v", err)
+	}
+}
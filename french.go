@@ -0,0 +1,121 @@
+package uax14
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// frenchBefore are marks which French spacing keeps with the spaces before
+// them.
+var frenchBefore = map[rune]bool{
+	';': true, ':': true, '!': true, '?': true,
+	'»': true, // U+00BB
+	'›': true, // U+203A
+}
+
+// frenchAfter are marks which French spacing keeps with the spaces after
+// them.
+var frenchAfter = map[rune]bool{
+	'«': true, // U+00AB
+	'‹': true, // U+2039
+}
+
+const (
+	// narrowNoBreakSpace is U+202F NARROW NO-BREAK SPACE, of class GL.
+	narrowNoBreakSpace = '\u202F'
+	// noBreakSpace is U+00A0 NO-BREAK SPACE, of class GL, which French
+	// typesetting puts before a colon.
+	noBreakSpace = '\u00A0'
+)
+
+// WithFrenchSpacing returns a copy of options, which treats spaces before
+// ; : ! ? » and › and after « and ‹ as no-break spaces, as is the
+// convention in French. Text often has ordinary spaces there, where
+// typesetting would use U+202F NARROW NO-BREAK SPACE, or U+00A0 NO-BREAK
+// SPACE before a colon, so that a mark would otherwise be left alone at the
+// start of a line. See also [FrenchSpaces],
+// which rewrites such spaces.
+func (options Options) WithFrenchSpacing(enabled bool) Options {
+	return options.tailor(func(t *tailoring) {
+		t.frenchSpacing = enabled
+	})
+}
+
+// frenchSpaceAt reports whether pos, in data, is after spaces which French
+// spacing keeps with the marks around them, so that the break there is
+// suppressed.
+func frenchSpaceAt[T ~string | ~[]byte](data T, pos int, ov *overrides) bool {
+	if ov == nil || !ov.frenchSpacing || pos == 0 || pos >= len(data) {
+		return false
+	}
+	if !isSpaceBefore(data, pos, ov) {
+		return false
+	}
+	if frenchBefore[decodeRune(data[pos:])] {
+		return true
+	}
+	i := pos
+	for i > 0 && isSpaceBefore(data, i, ov) {
+		i -= utf8.RuneLen(decodeLastRune(data[:i]))
+	}
+	return i > 0 && frenchAfter[decodeLastRune(data[:i])]
+}
+
+// isSpaceBefore reports whether the character before pos, in data, is of
+// class SP.
+func isSpaceBefore[T ~string | ~[]byte](data T, pos int, ov *overrides) bool {
+	r := decodeLastRune(data[:pos])
+	if r == utf8.RuneError {
+		return false
+	}
+	p, _ := lookupPropertyWith(data[pos-utf8.RuneLen(r):pos], ov)
+	return p.is(_SP)
+}
+
+// FrenchSpaces returns s, with each run of spaces (class SP, such as U+0020)
+// before ; ! ? » and › or after « and ‹ replaced by one U+202F NARROW
+// NO-BREAK SPACE, and before : by one U+00A0 NO-BREAK SPACE, as in French
+// typesetting. Unlike
+// [Options.WithFrenchSpacing], which only affects line breaking, the result
+// also displays correctly elsewhere, and keeps the marks with their words
+// under any options.
+func FrenchSpaces(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	last := 0      // end of the text written
+	after := false // after « or ‹
+	for i := 0; i < len(s); {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		if p, _ := lookupProperty(s[i:]); !p.is(_SP) {
+			after = frenchAfter[r]
+			i += w
+			continue
+		}
+
+		// A run of spaces
+		start := i
+		for i < len(s) {
+			p, w := lookupProperty(s[i:])
+			if !p.is(_SP) {
+				break
+			}
+			i += w
+		}
+		next, _ := utf8.DecodeRuneInString(s[i:])
+		if after && i < len(s) || frenchBefore[next] && start > 0 {
+			b.WriteString(s[last:start])
+			if next == ':' && !after {
+				b.WriteRune(noBreakSpace)
+			} else {
+				b.WriteRune(narrowNoBreakSpace)
+			}
+			last = i
+		}
+		after = false
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
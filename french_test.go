@@ -0,0 +1,102 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFrenchSpacing(t *testing.T) {
+	ambiguous := DefaultOptions.WithQuotes(Quotes{Ambiguous: []rune{'«', '»'}})
+
+	tests := []struct {
+		name    string
+		in      string
+		options Options
+		want    []string
+	}{
+		{
+			name:    "default",
+			in:      "x« oui »",
+			options: DefaultOptions,
+			want:    []string{"x« ", "oui »"},
+		},
+		{
+			name:    "after a guillemet",
+			in:      "x« oui »",
+			options: DefaultOptions.WithFrenchSpacing(true),
+			want:    []string{"x« oui »"},
+		},
+		{
+			name:    "ambiguous quotes",
+			in:      "dit « oui » et",
+			options: ambiguous,
+			want:    []string{"dit ", "« ", "oui ", "» ", "et"},
+		},
+		{
+			name:    "ambiguous quotes, French spacing",
+			in:      "dit « oui » et",
+			options: ambiguous.WithFrenchSpacing(true),
+			want:    []string{"dit ", "« oui » ", "et"},
+		},
+		{
+			name:    "high punctuation",
+			in:      "Quoi ? Oui ; non : peut-être !",
+			options: DefaultOptions.WithFrenchSpacing(true),
+			want:    []string{"Quoi ? ", "Oui ; ", "non : ", "peut-", "être !"},
+		},
+		{
+			name:    "several spaces",
+			in:      "x«  oui",
+			options: DefaultOptions.WithFrenchSpacing(true),
+			want:    []string{"x«  oui"},
+		},
+		{
+			name:    "disabled",
+			in:      "x« oui »",
+			options: DefaultOptions.WithFrenchSpacing(true).WithFrenchSpacing(false),
+			want:    []string{"x« ", "oui »"},
+		},
+		{
+			name:    "profile",
+			in:      "x« oui »",
+			options: ProfileForLanguage("fr-FR"),
+			want:    []string{"x« oui »"},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{tt.options, tt.options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				got := segments(tt.in, options)
+				if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+					t.Fatalf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestFrenchSpaces(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Quoi ? Oui !", "Quoi\u202F? Oui\u202F!"},
+		{"« Bonjour », dit-il ; puis : rien.", "«\u202FBonjour\u202F», dit-il\u202F; puis\u00A0: rien."},
+		{"«  oui  »", "«\u202Foui\u202F»"},
+		{"pas de changement", "pas de changement"},
+		{" ?", " ?"},
+		{"« ", "« "},
+		{"a\u00A0?", "a\u00A0?"},
+		{"Note :", "Note\u00A0:"},
+		{"« :", "«\u202F:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := FrenchSpaces(tt.in); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
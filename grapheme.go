@@ -0,0 +1,172 @@
+package uax14
+
+func (p graphemeProperty) is(properties graphemeProperty) bool {
+	return (p & properties) != 0
+}
+
+// incbState tracks state for GB9c (Indic conjunct clusters).
+// Pattern: Consonant (Extend|Linker)* Linker (Extend|Linker)* × Consonant
+type incbState uint8
+
+const (
+	incbNone      incbState = iota // initial/reset
+	incbConsonant                  // seen Consonant, awaiting Linker
+	incbLinker                     // seen Consonant and Linker (conjunct ready)
+)
+
+// nextGrapheme returns the length of the first extended grapheme cluster of
+// data.
+//
+// See https://unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries.
+func nextGrapheme[T ~string | ~[]byte](data T) int {
+	if len(data) == 0 {
+		return 0
+	}
+
+	// These vars are stateful across loop iterations
+	var pos int
+	var lastExExtend graphemeProperty     // "last excluding Extend"
+	var lastLastExExtend graphemeProperty // "last one before that"
+	var regionalIndicatorCount int
+	var incb incbState
+
+	current, w := lookupGrapheme(data[pos:])
+	if w == 0 {
+		return len(data)
+	}
+
+	// https://unicode.org/reports/tr29/#GB1
+	// Start of text always advances
+	pos += w
+
+	for {
+		// https://unicode.org/reports/tr29/#GB2
+		if pos == len(data) {
+			return pos
+		}
+
+		// Remember previous properties to avoid lookbacks
+		last := current
+		if !last.is(_gExtend) {
+			lastLastExExtend = lastExExtend
+			lastExExtend = last
+		}
+
+		// Update GB9c state based on what we just advanced past
+		switch {
+		case last.is(_gInCBConsonant):
+			if incb != incbLinker {
+				incb = incbConsonant
+			}
+		case last.is(_gInCBLinker):
+			if incb >= incbConsonant {
+				incb = incbLinker
+			}
+		case last.is(_gInCBExtend):
+			// Stay in the current state
+		default:
+			incb = incbNone
+		}
+
+		current, w = lookupGrapheme(data[pos:])
+		if w == 0 {
+			return len(data)
+		}
+
+		// Optimization: no rule can possibly apply
+		if current|last == 0 {
+			return pos
+		}
+
+		// https://unicode.org/reports/tr29/#GB3
+		if current.is(_gLF) && last.is(_gCR) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB4
+		// https://unicode.org/reports/tr29/#GB5
+		if (current | last).is(_gControl | _gCR | _gLF) {
+			return pos
+		}
+
+		// https://unicode.org/reports/tr29/#GB6
+		if current.is(_gL|_gV|_gLV|_gLVT) && last.is(_gL) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB7
+		if current.is(_gV|_gT) && last.is(_gLV|_gV) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB8
+		if current.is(_gT) && last.is(_gLVT|_gT) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB9
+		if current.is(_gExtend | _gZWJ) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB9a
+		if current.is(_gSpacingMark) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB9b
+		if last.is(_gPrepend) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB9c
+		if incb == incbLinker && current.is(_gInCBConsonant) {
+			// The current Consonant begins a new pattern
+			incb = incbConsonant
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB11
+		if current.is(_gExtPict) && last.is(_gZWJ) && lastLastExExtend.is(_gExtPict) {
+			pos += w
+			continue
+		}
+
+		// https://unicode.org/reports/tr29/#GB12
+		// https://unicode.org/reports/tr29/#GB13
+		if (current & last).is(_gRI) {
+			regionalIndicatorCount++
+			if regionalIndicatorCount%2 == 1 {
+				pos += w
+				continue
+			}
+		}
+
+		// https://unicode.org/reports/tr29/#GB999
+		return pos
+	}
+}
+
+// graphemeCursor finds successive grapheme cluster boundaries in data.
+type graphemeCursor[T ~string | ~[]byte] struct {
+	data T
+	// end is the end of the cluster containing the last position tested
+	end int
+}
+
+// isBoundary reports whether pos is a grapheme cluster boundary. Successive
+// calls must be at increasing positions.
+func (c *graphemeCursor[T]) isBoundary(pos int) bool {
+	for c.end < pos {
+		c.end += nextGrapheme(c.data[c.end:])
+	}
+	return c.end == pos
+}
@@ -0,0 +1,773 @@
+package uax14
+
+// Code generated by internal/gen; DO NOT EDIT.
+// Source: https://unicode.org/Public/17.0.0/ucd/auxiliary/GraphemeBreakTest.txt
+
+var graphemeTests = [766]conformanceTest{
+	{lineNo: 27, input: []byte{0xd, 0xd}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 28, input: []byte{0xd, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 29, input: []byte{0xd, 0xa}, breakOffsets: []int{0, 2}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) × [3.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 30, input: []byte{0xd, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 31, input: []byte{0xd, 0x0}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 32, input: []byte{0xd, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 33, input: []byte{0xd, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 34, input: []byte{0xd, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 35, input: []byte{0xd, 0xcc, 0x80}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 36, input: []byte{0xd, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 1, 5}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 37, input: []byte{0xd, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 38, input: []byte{0xd, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 39, input: []byte{0xd, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 40, input: []byte{0xd, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 41, input: []byte{0xd, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 1, 5}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 42, input: []byte{0xd, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 1, 3, 7}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 43, input: []byte{0xd, 0xdb, 0x9d}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 44, input: []byte{0xd, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 45, input: []byte{0xd, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 46, input: []byte{0xd, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 47, input: []byte{0xd, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 48, input: []byte{0xd, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 49, input: []byte{0xd, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 50, input: []byte{0xd, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 51, input: []byte{0xd, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 52, input: []byte{0xd, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 53, input: []byte{0xd, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 54, input: []byte{0xd, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 55, input: []byte{0xd, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 56, input: []byte{0xd, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 57, input: []byte{0xd, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 58, input: []byte{0xd, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 59, input: []byte{0xd, 0xc2, 0xa9}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 60, input: []byte{0xd, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 61, input: []byte{0xd, 0x20}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 62, input: []byte{0xd, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 63, input: []byte{0xd, 0xcd, 0xb8}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 64, input: []byte{0xd, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 65, input: []byte{0xa, 0xd}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 66, input: []byte{0xa, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 67, input: []byte{0xa, 0xa}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 68, input: []byte{0xa, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 69, input: []byte{0xa, 0x0}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 70, input: []byte{0xa, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 71, input: []byte{0xa, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 72, input: []byte{0xa, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 73, input: []byte{0xa, 0xcc, 0x80}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 74, input: []byte{0xa, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 1, 5}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 75, input: []byte{0xa, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 76, input: []byte{0xa, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 77, input: []byte{0xa, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 78, input: []byte{0xa, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 79, input: []byte{0xa, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 1, 5}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 80, input: []byte{0xa, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 1, 3, 7}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 81, input: []byte{0xa, 0xdb, 0x9d}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 82, input: []byte{0xa, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 83, input: []byte{0xa, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 84, input: []byte{0xa, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 85, input: []byte{0xa, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 86, input: []byte{0xa, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 87, input: []byte{0xa, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 88, input: []byte{0xa, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 89, input: []byte{0xa, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 90, input: []byte{0xa, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 91, input: []byte{0xa, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 92, input: []byte{0xa, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 93, input: []byte{0xa, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 94, input: []byte{0xa, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 95, input: []byte{0xa, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 96, input: []byte{0xa, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 97, input: []byte{0xa, 0xc2, 0xa9}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 98, input: []byte{0xa, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 99, input: []byte{0xa, 0x20}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 100, input: []byte{0xa, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 101, input: []byte{0xa, 0xcd, 0xb8}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 102, input: []byte{0xa, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 103, input: []byte{0x0, 0xd}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 104, input: []byte{0x0, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 105, input: []byte{0x0, 0xa}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 106, input: []byte{0x0, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 107, input: []byte{0x0, 0x0}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 108, input: []byte{0x0, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 109, input: []byte{0x0, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 110, input: []byte{0x0, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 111, input: []byte{0x0, 0xcc, 0x80}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 112, input: []byte{0x0, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 1, 5}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 113, input: []byte{0x0, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 114, input: []byte{0x0, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 115, input: []byte{0x0, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 116, input: []byte{0x0, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 117, input: []byte{0x0, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 1, 5}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 118, input: []byte{0x0, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 1, 3, 7}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 119, input: []byte{0x0, 0xdb, 0x9d}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 120, input: []byte{0x0, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 121, input: []byte{0x0, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 122, input: []byte{0x0, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 1, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 123, input: []byte{0x0, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 124, input: []byte{0x0, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 125, input: []byte{0x0, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 126, input: []byte{0x0, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 127, input: []byte{0x0, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 128, input: []byte{0x0, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 129, input: []byte{0x0, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 130, input: []byte{0x0, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 131, input: []byte{0x0, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 132, input: []byte{0x0, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 133, input: []byte{0x0, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 134, input: []byte{0x0, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 1, 3, 6}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 135, input: []byte{0x0, 0xc2, 0xa9}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 136, input: []byte{0x0, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 137, input: []byte{0x0, 0x20}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 138, input: []byte{0x0, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 1, 3, 4}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 139, input: []byte{0x0, 0xcd, 0xb8}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 140, input: []byte{0x0, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 1, 3, 5}, comment: "÷ [0.2] <NULL> (Control) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 141, input: []byte{0xe0, 0xa5, 0x8d, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 142, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 143, input: []byte{0xe0, 0xa5, 0x8d, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 144, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 145, input: []byte{0xe0, 0xa5, 0x8d, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 146, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 147, input: []byte{0xe0, 0xa5, 0x8d, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 148, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 149, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 150, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 151, input: []byte{0xe0, 0xa5, 0x8d, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 152, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 153, input: []byte{0xe0, 0xa5, 0x8d, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 154, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 155, input: []byte{0xe0, 0xa5, 0x8d, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 156, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 157, input: []byte{0xe0, 0xa5, 0x8d, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 158, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 159, input: []byte{0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 160, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 161, input: []byte{0xe0, 0xa5, 0x8d, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 162, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 163, input: []byte{0xe0, 0xa5, 0x8d, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 164, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 165, input: []byte{0xe0, 0xa5, 0x8d, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 166, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 167, input: []byte{0xe0, 0xa5, 0x8d, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 168, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 169, input: []byte{0xe0, 0xa5, 0x8d, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 170, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 171, input: []byte{0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 172, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 173, input: []byte{0xe0, 0xa5, 0x8d, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 174, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 175, input: []byte{0xe0, 0xa5, 0x8d, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 176, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 177, input: []byte{0xe0, 0xa5, 0x8d, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 178, input: []byte{0xe0, 0xa5, 0x8d, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 179, input: []byte{0xcc, 0x80, 0xd}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 180, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 181, input: []byte{0xcc, 0x80, 0xa}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 182, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 183, input: []byte{0xcc, 0x80, 0x0}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 184, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 185, input: []byte{0xcc, 0x80, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 186, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 187, input: []byte{0xcc, 0x80, 0xcc, 0x80}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 188, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 189, input: []byte{0xcc, 0x80, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 190, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 191, input: []byte{0xcc, 0x80, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 192, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 193, input: []byte{0xcc, 0x80, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 2, 6}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 194, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 4, 8}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 195, input: []byte{0xcc, 0x80, 0xdb, 0x9d}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 196, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 197, input: []byte{0xcc, 0x80, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 198, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 199, input: []byte{0xcc, 0x80, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 200, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 201, input: []byte{0xcc, 0x80, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 202, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 203, input: []byte{0xcc, 0x80, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 204, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 205, input: []byte{0xcc, 0x80, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 206, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 207, input: []byte{0xcc, 0x80, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 208, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 209, input: []byte{0xcc, 0x80, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 210, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 211, input: []byte{0xcc, 0x80, 0xc2, 0xa9}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 212, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 213, input: []byte{0xcc, 0x80, 0x20}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 214, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 215, input: []byte{0xcc, 0x80, 0xcd, 0xb8}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 216, input: []byte{0xcc, 0x80, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 217, input: []byte{0xe2, 0x80, 0x8c, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 218, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 219, input: []byte{0xe2, 0x80, 0x8c, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 220, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 221, input: []byte{0xe2, 0x80, 0x8c, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 222, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 223, input: []byte{0xe2, 0x80, 0x8c, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 224, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 225, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 226, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 227, input: []byte{0xe2, 0x80, 0x8c, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 228, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 229, input: []byte{0xe2, 0x80, 0x8c, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 230, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 231, input: []byte{0xe2, 0x80, 0x8c, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 232, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 233, input: []byte{0xe2, 0x80, 0x8c, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 234, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 235, input: []byte{0xe2, 0x80, 0x8c, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 236, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 237, input: []byte{0xe2, 0x80, 0x8c, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 238, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 239, input: []byte{0xe2, 0x80, 0x8c, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 240, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 241, input: []byte{0xe2, 0x80, 0x8c, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 242, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 243, input: []byte{0xe2, 0x80, 0x8c, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 244, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 245, input: []byte{0xe2, 0x80, 0x8c, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 246, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 247, input: []byte{0xe2, 0x80, 0x8c, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 248, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 249, input: []byte{0xe2, 0x80, 0x8c, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 250, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 251, input: []byte{0xe2, 0x80, 0x8c, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 252, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 253, input: []byte{0xe2, 0x80, 0x8c, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 254, input: []byte{0xe2, 0x80, 0x8c, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 255, input: []byte{0xe2, 0x80, 0x8d, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 256, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 257, input: []byte{0xe2, 0x80, 0x8d, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 258, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 259, input: []byte{0xe2, 0x80, 0x8d, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 260, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 261, input: []byte{0xe2, 0x80, 0x8d, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 262, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 263, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 264, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 265, input: []byte{0xe2, 0x80, 0x8d, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 266, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 267, input: []byte{0xe2, 0x80, 0x8d, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 268, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 269, input: []byte{0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 270, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 271, input: []byte{0xe2, 0x80, 0x8d, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 272, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 273, input: []byte{0xe2, 0x80, 0x8d, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 274, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 275, input: []byte{0xe2, 0x80, 0x8d, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 276, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 277, input: []byte{0xe2, 0x80, 0x8d, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 278, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 279, input: []byte{0xe2, 0x80, 0x8d, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 280, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 281, input: []byte{0xe2, 0x80, 0x8d, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 282, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 283, input: []byte{0xe2, 0x80, 0x8d, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 284, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 285, input: []byte{0xe2, 0x80, 0x8d, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 286, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 287, input: []byte{0xe2, 0x80, 0x8d, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 288, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 289, input: []byte{0xe2, 0x80, 0x8d, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 290, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 291, input: []byte{0xe2, 0x80, 0x8d, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 292, input: []byte{0xe2, 0x80, 0x8d, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] ZERO WIDTH JOINER (ZWJ) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 293, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xd}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 294, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 6, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 295, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xa}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 296, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 6, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 297, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0x0}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 298, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 6, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 299, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 300, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 301, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 302, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 303, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 304, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 305, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 306, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 307, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [12.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 308, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 6, 10}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 309, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xdb, 0x9d}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 310, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 6, 8}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 311, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 312, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 313, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 314, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 315, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 316, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 317, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 318, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 319, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 320, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 321, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 322, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 323, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 324, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 325, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xc2, 0xa9}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 326, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 6, 8}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 327, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0x20}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 328, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 6, 7}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 329, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcd, 0xb8}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 330, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 6, 8}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 331, input: []byte{0xdb, 0x9d, 0xd}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 332, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 333, input: []byte{0xdb, 0x9d, 0xa}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 334, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 335, input: []byte{0xdb, 0x9d, 0x0}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 336, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 337, input: []byte{0xdb, 0x9d, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 338, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 339, input: []byte{0xdb, 0x9d, 0xcc, 0x80}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 340, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 341, input: []byte{0xdb, 0x9d, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 342, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 343, input: []byte{0xdb, 0x9d, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 344, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 345, input: []byte{0xdb, 0x9d, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 346, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 4, 8}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 347, input: []byte{0xdb, 0x9d, 0xdb, 0x9d}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 348, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 349, input: []byte{0xdb, 0x9d, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 350, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 351, input: []byte{0xdb, 0x9d, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 352, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 353, input: []byte{0xdb, 0x9d, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 354, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 355, input: []byte{0xdb, 0x9d, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 356, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 357, input: []byte{0xdb, 0x9d, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 358, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 359, input: []byte{0xdb, 0x9d, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 360, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 361, input: []byte{0xdb, 0x9d, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 362, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 363, input: []byte{0xdb, 0x9d, 0xc2, 0xa9}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 364, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 365, input: []byte{0xdb, 0x9d, 0x20}, breakOffsets: []int{0, 3}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 366, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 367, input: []byte{0xdb, 0x9d, 0xcd, 0xb8}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 368, input: []byte{0xdb, 0x9d, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] ARABIC END OF AYAH (Prepend) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 369, input: []byte{0xe0, 0xa4, 0x83, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 370, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 371, input: []byte{0xe0, 0xa4, 0x83, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 372, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 373, input: []byte{0xe0, 0xa4, 0x83, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 374, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 375, input: []byte{0xe0, 0xa4, 0x83, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 376, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 377, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 378, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 379, input: []byte{0xe0, 0xa4, 0x83, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 380, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 381, input: []byte{0xe0, 0xa4, 0x83, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 382, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 383, input: []byte{0xe0, 0xa4, 0x83, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 384, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 385, input: []byte{0xe0, 0xa4, 0x83, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 386, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 387, input: []byte{0xe0, 0xa4, 0x83, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 388, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 389, input: []byte{0xe0, 0xa4, 0x83, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 390, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 391, input: []byte{0xe0, 0xa4, 0x83, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 392, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 393, input: []byte{0xe0, 0xa4, 0x83, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 394, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 395, input: []byte{0xe0, 0xa4, 0x83, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 396, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 397, input: []byte{0xe0, 0xa4, 0x83, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 398, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 399, input: []byte{0xe0, 0xa4, 0x83, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 400, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 401, input: []byte{0xe0, 0xa4, 0x83, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 402, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 403, input: []byte{0xe0, 0xa4, 0x83, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 404, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 405, input: []byte{0xe0, 0xa4, 0x83, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 406, input: []byte{0xe0, 0xa4, 0x83, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI SIGN VISARGA (SpacingMark) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 407, input: []byte{0xe1, 0x84, 0x80, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 408, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 409, input: []byte{0xe1, 0x84, 0x80, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 410, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 411, input: []byte{0xe1, 0x84, 0x80, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 412, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 413, input: []byte{0xe1, 0x84, 0x80, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 414, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 415, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 416, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 417, input: []byte{0xe1, 0x84, 0x80, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 418, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 419, input: []byte{0xe1, 0x84, 0x80, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 420, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 421, input: []byte{0xe1, 0x84, 0x80, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 422, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 423, input: []byte{0xe1, 0x84, 0x80, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 424, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 425, input: []byte{0xe1, 0x84, 0x80, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 426, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 427, input: []byte{0xe1, 0x84, 0x80, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [6.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 428, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 429, input: []byte{0xe1, 0x84, 0x80, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [6.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 430, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 431, input: []byte{0xe1, 0x84, 0x80, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 432, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 433, input: []byte{0xe1, 0x84, 0x80, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [6.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 434, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 435, input: []byte{0xe1, 0x84, 0x80, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [6.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 436, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 437, input: []byte{0xe1, 0x84, 0x80, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 438, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 439, input: []byte{0xe1, 0x84, 0x80, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 440, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 441, input: []byte{0xe1, 0x84, 0x80, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 442, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 443, input: []byte{0xe1, 0x84, 0x80, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 444, input: []byte{0xe1, 0x84, 0x80, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 445, input: []byte{0xe1, 0x85, 0xa0, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 446, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 447, input: []byte{0xe1, 0x85, 0xa0, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 448, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 449, input: []byte{0xe1, 0x85, 0xa0, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 450, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 451, input: []byte{0xe1, 0x85, 0xa0, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 452, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 453, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 454, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 455, input: []byte{0xe1, 0x85, 0xa0, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 456, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 457, input: []byte{0xe1, 0x85, 0xa0, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 458, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 459, input: []byte{0xe1, 0x85, 0xa0, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 460, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 461, input: []byte{0xe1, 0x85, 0xa0, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 462, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 463, input: []byte{0xe1, 0x85, 0xa0, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 464, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 465, input: []byte{0xe1, 0x85, 0xa0, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 466, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 467, input: []byte{0xe1, 0x85, 0xa0, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [7.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 468, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 469, input: []byte{0xe1, 0x85, 0xa0, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [7.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 470, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 471, input: []byte{0xe1, 0x85, 0xa0, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 472, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 473, input: []byte{0xe1, 0x85, 0xa0, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 474, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 475, input: []byte{0xe1, 0x85, 0xa0, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 476, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 477, input: []byte{0xe1, 0x85, 0xa0, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 478, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 479, input: []byte{0xe1, 0x85, 0xa0, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 480, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 481, input: []byte{0xe1, 0x85, 0xa0, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 482, input: []byte{0xe1, 0x85, 0xa0, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL JUNGSEONG FILLER (V) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 483, input: []byte{0xe1, 0x86, 0xa8, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 484, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 485, input: []byte{0xe1, 0x86, 0xa8, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 486, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 487, input: []byte{0xe1, 0x86, 0xa8, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 488, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 489, input: []byte{0xe1, 0x86, 0xa8, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 490, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 491, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 492, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 493, input: []byte{0xe1, 0x86, 0xa8, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 494, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 495, input: []byte{0xe1, 0x86, 0xa8, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 496, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 497, input: []byte{0xe1, 0x86, 0xa8, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 498, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 499, input: []byte{0xe1, 0x86, 0xa8, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 500, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 501, input: []byte{0xe1, 0x86, 0xa8, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 502, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 503, input: []byte{0xe1, 0x86, 0xa8, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 504, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 505, input: []byte{0xe1, 0x86, 0xa8, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 506, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 507, input: []byte{0xe1, 0x86, 0xa8, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [8.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 508, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 509, input: []byte{0xe1, 0x86, 0xa8, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 510, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 511, input: []byte{0xe1, 0x86, 0xa8, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 512, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 513, input: []byte{0xe1, 0x86, 0xa8, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 514, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 515, input: []byte{0xe1, 0x86, 0xa8, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 516, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 517, input: []byte{0xe1, 0x86, 0xa8, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 518, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 519, input: []byte{0xe1, 0x86, 0xa8, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 520, input: []byte{0xe1, 0x86, 0xa8, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL JONGSEONG KIYEOK (T) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 521, input: []byte{0xea, 0xb0, 0x80, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 522, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 523, input: []byte{0xea, 0xb0, 0x80, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 524, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 525, input: []byte{0xea, 0xb0, 0x80, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 526, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 527, input: []byte{0xea, 0xb0, 0x80, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 528, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 529, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 530, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 531, input: []byte{0xea, 0xb0, 0x80, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 532, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 533, input: []byte{0xea, 0xb0, 0x80, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 534, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 535, input: []byte{0xea, 0xb0, 0x80, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 536, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 537, input: []byte{0xea, 0xb0, 0x80, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 538, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 539, input: []byte{0xea, 0xb0, 0x80, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 540, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 541, input: []byte{0xea, 0xb0, 0x80, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 542, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 543, input: []byte{0xea, 0xb0, 0x80, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [7.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 544, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 545, input: []byte{0xea, 0xb0, 0x80, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [7.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 546, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 547, input: []byte{0xea, 0xb0, 0x80, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 548, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 549, input: []byte{0xea, 0xb0, 0x80, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 550, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 551, input: []byte{0xea, 0xb0, 0x80, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 552, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 553, input: []byte{0xea, 0xb0, 0x80, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 554, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 555, input: []byte{0xea, 0xb0, 0x80, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 556, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 557, input: []byte{0xea, 0xb0, 0x80, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 558, input: []byte{0xea, 0xb0, 0x80, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 559, input: []byte{0xea, 0xb0, 0x81, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 560, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 561, input: []byte{0xea, 0xb0, 0x81, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 562, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 563, input: []byte{0xea, 0xb0, 0x81, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 564, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 565, input: []byte{0xea, 0xb0, 0x81, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 566, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 567, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 568, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 569, input: []byte{0xea, 0xb0, 0x81, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 570, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 571, input: []byte{0xea, 0xb0, 0x81, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 572, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 573, input: []byte{0xea, 0xb0, 0x81, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 574, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 575, input: []byte{0xea, 0xb0, 0x81, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 576, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 577, input: []byte{0xea, 0xb0, 0x81, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 578, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 579, input: []byte{0xea, 0xb0, 0x81, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 580, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 581, input: []byte{0xea, 0xb0, 0x81, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 582, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 583, input: []byte{0xea, 0xb0, 0x81, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [8.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 584, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 585, input: []byte{0xea, 0xb0, 0x81, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 586, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 587, input: []byte{0xea, 0xb0, 0x81, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 588, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 589, input: []byte{0xea, 0xb0, 0x81, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 590, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 591, input: []byte{0xea, 0xb0, 0x81, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 592, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 593, input: []byte{0xea, 0xb0, 0x81, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 594, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 595, input: []byte{0xea, 0xb0, 0x81, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 596, input: []byte{0xea, 0xb0, 0x81, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 597, input: []byte{0xe0, 0xa4, 0x95, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 598, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 599, input: []byte{0xe0, 0xa4, 0x95, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 600, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 601, input: []byte{0xe0, 0xa4, 0x95, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 602, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 603, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 604, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 605, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 606, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 607, input: []byte{0xe0, 0xa4, 0x95, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 608, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 609, input: []byte{0xe0, 0xa4, 0x95, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 610, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 611, input: []byte{0xe0, 0xa4, 0x95, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 612, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 613, input: []byte{0xe0, 0xa4, 0x95, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 614, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 615, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 616, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 617, input: []byte{0xe0, 0xa4, 0x95, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 618, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 619, input: []byte{0xe0, 0xa4, 0x95, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 620, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 621, input: []byte{0xe0, 0xa4, 0x95, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 622, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 623, input: []byte{0xe0, 0xa4, 0x95, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 624, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 625, input: []byte{0xe0, 0xa4, 0x95, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 626, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 627, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 628, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 5, 8}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 629, input: []byte{0xe0, 0xa4, 0x95, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 630, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 631, input: []byte{0xe0, 0xa4, 0x95, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 632, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 633, input: []byte{0xe0, 0xa4, 0x95, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 634, input: []byte{0xe0, 0xa4, 0x95, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 5, 7}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 635, input: []byte{0xc2, 0xa9, 0xd}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 636, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 637, input: []byte{0xc2, 0xa9, 0xa}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 638, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 639, input: []byte{0xc2, 0xa9, 0x0}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 640, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 641, input: []byte{0xc2, 0xa9, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 642, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 643, input: []byte{0xc2, 0xa9, 0xcc, 0x80}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 644, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 645, input: []byte{0xc2, 0xa9, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 646, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 647, input: []byte{0xc2, 0xa9, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 648, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 649, input: []byte{0xc2, 0xa9, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 2, 6}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 650, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 4, 8}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 651, input: []byte{0xc2, 0xa9, 0xdb, 0x9d}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 652, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 653, input: []byte{0xc2, 0xa9, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 654, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 655, input: []byte{0xc2, 0xa9, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 656, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 657, input: []byte{0xc2, 0xa9, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 658, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 659, input: []byte{0xc2, 0xa9, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 660, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 661, input: []byte{0xc2, 0xa9, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 662, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 663, input: []byte{0xc2, 0xa9, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 664, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 665, input: []byte{0xc2, 0xa9, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 666, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 667, input: []byte{0xc2, 0xa9, 0xc2, 0xa9}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 668, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 669, input: []byte{0xc2, 0xa9, 0x20}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 670, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 671, input: []byte{0xc2, 0xa9, 0xcd, 0xb8}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 672, input: []byte{0xc2, 0xa9, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] COPYRIGHT SIGN (ExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 673, input: []byte{0x20, 0xd}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 674, input: []byte{0x20, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 675, input: []byte{0x20, 0xa}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 676, input: []byte{0x20, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 677, input: []byte{0x20, 0x0}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 678, input: []byte{0x20, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 679, input: []byte{0x20, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 680, input: []byte{0x20, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 681, input: []byte{0x20, 0xcc, 0x80}, breakOffsets: []int{0, 3}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 682, input: []byte{0x20, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 683, input: []byte{0x20, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 684, input: []byte{0x20, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 685, input: []byte{0x20, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 686, input: []byte{0x20, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 687, input: []byte{0x20, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 1, 5}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 688, input: []byte{0x20, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 3, 7}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 689, input: []byte{0x20, 0xdb, 0x9d}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 690, input: []byte{0x20, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 691, input: []byte{0x20, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 692, input: []byte{0x20, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 693, input: []byte{0x20, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 694, input: []byte{0x20, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 695, input: []byte{0x20, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 696, input: []byte{0x20, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 697, input: []byte{0x20, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 698, input: []byte{0x20, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 699, input: []byte{0x20, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 700, input: []byte{0x20, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 701, input: []byte{0x20, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 702, input: []byte{0x20, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 703, input: []byte{0x20, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 704, input: []byte{0x20, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 705, input: []byte{0x20, 0xc2, 0xa9}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 706, input: []byte{0x20, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 707, input: []byte{0x20, 0x20}, breakOffsets: []int{0, 1, 2}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 708, input: []byte{0x20, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 709, input: []byte{0x20, 0xcd, 0xb8}, breakOffsets: []int{0, 1, 3}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 710, input: []byte{0x20, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 3, 5}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 711, input: []byte{0xcd, 0xb8, 0xd}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 712, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xd}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <CARRIAGE RETURN (CR)> (CR) ÷ [0.3]"},
+	{lineNo: 713, input: []byte{0xcd, 0xb8, 0xa}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 714, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xa}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [0.3]"},
+	{lineNo: 715, input: []byte{0xcd, 0xb8, 0x0}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 716, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0x0}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [5.0] <NULL> (Control) ÷ [0.3]"},
+	{lineNo: 717, input: []byte{0xcd, 0xb8, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 718, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe0, 0xa5, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [0.3]"},
+	{lineNo: 719, input: []byte{0xcd, 0xb8, 0xcc, 0x80}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 720, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xcc, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING GRAVE ACCENT (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 721, input: []byte{0xcd, 0xb8, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 722, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe2, 0x80, 0x8c}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH NON-JOINER (ExtendmConjunctLinkermConjunctExtender) ÷ [0.3]"},
+	{lineNo: 723, input: []byte{0xcd, 0xb8, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 724, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 725, input: []byte{0xcd, 0xb8, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 2, 6}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 726, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xf0, 0x9f, 0x87, 0xa6}, breakOffsets: []int{0, 4, 8}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) ÷ [0.3]"},
+	{lineNo: 727, input: []byte{0xcd, 0xb8, 0xdb, 0x9d}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 728, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xdb, 0x9d}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] ARABIC END OF AYAH (Prepend) ÷ [0.3]"},
+	{lineNo: 729, input: []byte{0xcd, 0xb8, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 730, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe0, 0xa4, 0x83}, breakOffsets: []int{0, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [0.3]"},
+	{lineNo: 731, input: []byte{0xcd, 0xb8, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 732, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 733, input: []byte{0xcd, 0xb8, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 734, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe1, 0x85, 0xa0}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JUNGSEONG FILLER (V) ÷ [0.3]"},
+	{lineNo: 735, input: []byte{0xcd, 0xb8, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 736, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe1, 0x86, 0xa8}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL JONGSEONG KIYEOK (T) ÷ [0.3]"},
+	{lineNo: 737, input: []byte{0xcd, 0xb8, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 738, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xea, 0xb0, 0x80}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GA (LV) ÷ [0.3]"},
+	{lineNo: 739, input: []byte{0xcd, 0xb8, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 740, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xea, 0xb0, 0x81}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] HANGUL SYLLABLE GAG (LVT) ÷ [0.3]"},
+	{lineNo: 741, input: []byte{0xcd, 0xb8, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 2, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 742, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xe0, 0xa4, 0x95}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 743, input: []byte{0xcd, 0xb8, 0xc2, 0xa9}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 744, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xc2, 0xa9}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] COPYRIGHT SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 745, input: []byte{0xcd, 0xb8, 0x20}, breakOffsets: []int{0, 2, 3}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 746, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0x20}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 747, input: []byte{0xcd, 0xb8, 0xcd, 0xb8}, breakOffsets: []int{0, 2, 4}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 748, input: []byte{0xcd, 0xb8, 0xcc, 0x88, 0xcd, 0xb8}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] <reserved-0378> (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] <reserved-0378> (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 749, input: []byte{0xd, 0xa, 0x61, 0xa, 0xcc, 0x88}, breakOffsets: []int{0, 2, 3, 4, 6}, comment: "÷ [0.2] <CARRIAGE RETURN (CR)> (CR) × [3.0] <LINE FEED (LF)> (LF) ÷ [4.0] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) ÷ [5.0] <LINE FEED (LF)> (LF) ÷ [4.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 750, input: []byte{0x61, 0xcc, 0x88}, breakOffsets: []int{0, 3}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 751, input: []byte{0x20, 0xe2, 0x80, 0x8d, 0xd9, 0x86}, breakOffsets: []int{0, 4, 6}, comment: "÷ [0.2] SPACE (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] ARABIC LETTER NOON (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 752, input: []byte{0xd9, 0x86, 0xe2, 0x80, 0x8d, 0x20}, breakOffsets: []int{0, 5, 6}, comment: "÷ [0.2] ARABIC LETTER NOON (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] SPACE (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 753, input: []byte{0xe1, 0x84, 0x80, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 6}, comment: "÷ [0.2] HANGUL CHOSEONG KIYEOK (L) × [6.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 754, input: []byte{0xea, 0xb0, 0x80, 0xe1, 0x86, 0xa8, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] HANGUL SYLLABLE GA (LV) × [7.0] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 755, input: []byte{0xea, 0xb0, 0x81, 0xe1, 0x86, 0xa8, 0xe1, 0x84, 0x80}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] HANGUL SYLLABLE GAG (LVT) × [8.0] HANGUL JONGSEONG KIYEOK (T) ÷ [999.0] HANGUL CHOSEONG KIYEOK (L) ÷ [0.3]"},
+	{lineNo: 756, input: []byte{0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa8, 0x62}, breakOffsets: []int{0, 8, 12, 13}, comment: "÷ [0.2] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [12.0] REGIONAL INDICATOR SYMBOL LETTER B (RI) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER C (RI) ÷ [999.0] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 757, input: []byte{0x61, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa8, 0x62}, breakOffsets: []int{0, 1, 9, 13, 14}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [13.0] REGIONAL INDICATOR SYMBOL LETTER B (RI) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER C (RI) ÷ [999.0] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 758, input: []byte{0x61, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x87, 0xa8, 0x62}, breakOffsets: []int{0, 1, 12, 16, 17}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [13.0] REGIONAL INDICATOR SYMBOL LETTER B (RI) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER C (RI) ÷ [999.0] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 759, input: []byte{0x61, 0xf0, 0x9f, 0x87, 0xa6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa8, 0x62}, breakOffsets: []int{0, 1, 8, 16, 17}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER B (RI) × [13.0] REGIONAL INDICATOR SYMBOL LETTER C (RI) ÷ [999.0] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 760, input: []byte{0x61, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xa9, 0x62}, breakOffsets: []int{0, 1, 9, 17, 18}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER A (RI) × [13.0] REGIONAL INDICATOR SYMBOL LETTER B (RI) ÷ [999.0] REGIONAL INDICATOR SYMBOL LETTER C (RI) × [13.0] REGIONAL INDICATOR SYMBOL LETTER D (RI) ÷ [999.0] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 761, input: []byte{0x61, 0xe2, 0x80, 0x8d}, breakOffsets: []int{0, 4}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [0.3]"},
+	{lineNo: 762, input: []byte{0x61, 0xcc, 0x88, 0x62}, breakOffsets: []int{0, 3, 4}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 763, input: []byte{0x61, 0xe0, 0xa4, 0x83, 0x62}, breakOffsets: []int{0, 4, 5}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.1] DEVANAGARI SIGN VISARGA (SpacingMark) ÷ [999.0] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 764, input: []byte{0x61, 0xd8, 0x80, 0x62}, breakOffsets: []int{0, 1, 4}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) ÷ [999.0] ARABIC NUMBER SIGN (Prepend) × [9.2] LATIN SMALL LETTER B (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 765, input: []byte{0xf0, 0x9f, 0x91, 0xb6, 0xf0, 0x9f, 0x8f, 0xbf, 0xf0, 0x9f, 0x91, 0xb6}, breakOffsets: []int{0, 8, 12}, comment: "÷ [0.2] BABY (ExtPict) × [9.0] EMOJI MODIFIER FITZPATRICK TYPE-6 (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] BABY (ExtPict) ÷ [0.3]"},
+	{lineNo: 766, input: []byte{0x61, 0xf0, 0x9f, 0x8f, 0xbf, 0xf0, 0x9f, 0x91, 0xb6}, breakOffsets: []int{0, 5, 9}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] EMOJI MODIFIER FITZPATRICK TYPE-6 (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] BABY (ExtPict) ÷ [0.3]"},
+	{lineNo: 767, input: []byte{0x61, 0xf0, 0x9f, 0x8f, 0xbf, 0xf0, 0x9f, 0x91, 0xb6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9b, 0x91}, breakOffsets: []int{0, 5, 16}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] EMOJI MODIFIER FITZPATRICK TYPE-6 (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] BABY (ExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) × [11.0] OCTAGONAL SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 768, input: []byte{0xf0, 0x9f, 0x91, 0xb6, 0xf0, 0x9f, 0x8f, 0xbf, 0xcc, 0x88, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xb6, 0xf0, 0x9f, 0x8f, 0xbf}, breakOffsets: []int{0, 21}, comment: "÷ [0.2] BABY (ExtPict) × [9.0] EMOJI MODIFIER FITZPATRICK TYPE-6 (Extend_ConjunctExtendermConjunctLinker) × [9.0] COMBINING DIAERESIS (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) × [11.0] BABY (ExtPict) × [9.0] EMOJI MODIFIER FITZPATRICK TYPE-6 (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 769, input: []byte{0xf0, 0x9f, 0x9b, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9b, 0x91}, breakOffsets: []int{0, 11}, comment: "÷ [0.2] OCTAGONAL SIGN (ExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) × [11.0] OCTAGONAL SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 770, input: []byte{0x61, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9b, 0x91}, breakOffsets: []int{0, 4, 8}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] OCTAGONAL SIGN (ExtPict) ÷ [0.3]"},
+	{lineNo: 771, input: []byte{0xe2, 0x9c, 0x81, 0xe2, 0x80, 0x8d, 0xe2, 0x9c, 0x81}, breakOffsets: []int{0, 6, 9}, comment: "÷ [0.2] UPPER BLADE SCISSORS (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] UPPER BLADE SCISSORS (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 772, input: []byte{0x61, 0xe2, 0x80, 0x8d, 0xe2, 0x9c, 0x81}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] ZERO WIDTH JOINER (ZWJ) ÷ [999.0] UPPER BLADE SCISSORS (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 773, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 3, 6}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) ÷ [999.0] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 774, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 9}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 775, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa5, 0x8d, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 12}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 776, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa5, 0x8d, 0xe2, 0x80, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 12}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) × [9.3] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 777, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa4, 0xbc, 0xe2, 0x80, 0x8d, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 15}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN NUKTA (Extend_ConjunctExtendermConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 778, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa4, 0xbc, 0xe0, 0xa5, 0x8d, 0xe2, 0x80, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 15}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN NUKTA (Extend_ConjunctExtendermConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] ZERO WIDTH JOINER (ZWJ) × [9.3] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 779, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xa4, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xaf}, breakOffsets: []int{0, 15}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] DEVANAGARI LETTER TA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] DEVANAGARI LETTER YA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 780, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa5, 0x8d, 0x61}, breakOffsets: []int{0, 6, 7}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) ÷ [0.3]"},
+	{lineNo: 781, input: []byte{0x61, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] LATIN SMALL LETTER A (XXmLinkingConsonantmExtPict) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 782, input: []byte{0x3f, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 4, 7}, comment: "÷ [0.2] QUESTION MARK (XXmLinkingConsonantmExtPict) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) ÷ [999.0] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 783, input: []byte{0xe0, 0xa4, 0x95, 0xe0, 0xa5, 0x8d, 0xe0, 0xa5, 0x8d, 0xe0, 0xa4, 0xa4}, breakOffsets: []int{0, 12}, comment: "÷ [0.2] DEVANAGARI LETTER KA (LinkingConsonant) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.0] DEVANAGARI SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] DEVANAGARI LETTER TA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 784, input: []byte{0xe0, 0xaa, 0xb8, 0xe0, 0xab, 0xbb, 0xe0, 0xab, 0x8d, 0xe0, 0xaa, 0xb8, 0xe0, 0xab, 0xbb}, breakOffsets: []int{0, 15}, comment: "÷ [0.2] GUJARATI LETTER SA (LinkingConsonant) × [9.0] GUJARATI SIGN SHADDA (Extend_ConjunctExtendermConjunctLinker) × [9.0] GUJARATI SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] GUJARATI LETTER SA (LinkingConsonant) × [9.0] GUJARATI SIGN SHADDA (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 785, input: []byte{0xe1, 0x80, 0x99, 0xe1, 0x80, 0xb9, 0xe1, 0x80, 0x98, 0xe1, 0x80, 0xac, 0xe1, 0x80, 0xb7}, breakOffsets: []int{0, 9, 15}, comment: "÷ [0.2] MYANMAR LETTER MA (LinkingConsonant) × [9.0] MYANMAR SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] MYANMAR LETTER BHA (LinkingConsonant) ÷ [999.0] MYANMAR VOWEL SIGN AA (XXmLinkingConsonantmExtPict) × [9.0] MYANMAR SIGN DOT BELOW (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 786, input: []byte{0xe1, 0x80, 0x84, 0xe1, 0x80, 0xba, 0xe1, 0x80, 0xb9, 0xe1, 0x80, 0x91, 0xe1, 0x80, 0xb9, 0xe1, 0x80, 0x91}, breakOffsets: []int{0, 18}, comment: "÷ [0.2] MYANMAR LETTER NGA (LinkingConsonant) × [9.0] MYANMAR SIGN ASAT (Extend_ConjunctExtendermConjunctLinker) × [9.0] MYANMAR SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] MYANMAR LETTER THA (LinkingConsonant) × [9.0] MYANMAR SIGN VIRAMA (Extend_ConjunctLinker) × [9.3] MYANMAR LETTER THA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 787, input: []byte{0xe1, 0xac, 0x92, 0xe1, 0xac, 0x81, 0xe1, 0xac, 0xb2, 0xe1, 0xad, 0x84, 0xe1, 0xac, 0xaf, 0xe1, 0xac, 0xb2, 0xe1, 0xad, 0x84, 0xe1, 0xac, 0xa2, 0xe1, 0xad, 0x84, 0xe1, 0xac, 0xac, 0xe1, 0xac, 0xb2, 0xe1, 0xad, 0x84, 0xe1, 0xac, 0xa2, 0xe1, 0xac, 0xb8}, breakOffsets: []int{0, 6, 15, 30, 42}, comment: "÷ [0.2] BALINESE LETTER OKARA TEDUNG (XXmLinkingConsonantmExtPict) × [9.0] BALINESE SIGN ULU CANDRA (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] BALINESE LETTER SA (LinkingConsonant) × [9.0] BALINESE ADEG ADEG (Extend_ConjunctLinker) × [9.3] BALINESE LETTER WA (LinkingConsonant) ÷ [999.0] BALINESE LETTER SA (LinkingConsonant) × [9.0] BALINESE ADEG ADEG (Extend_ConjunctLinker) × [9.3] BALINESE LETTER TA (LinkingConsonant) × [9.0] BALINESE ADEG ADEG (Extend_ConjunctLinker) × [9.3] BALINESE LETTER YA (LinkingConsonant) ÷ [999.0] BALINESE LETTER SA (LinkingConsonant) × [9.0] BALINESE ADEG ADEG (Extend_ConjunctLinker) × [9.3] BALINESE LETTER TA (LinkingConsonant) × [9.0] BALINESE VOWEL SIGN SUKU (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 788, input: []byte{0xe1, 0x9e, 0x9f, 0xe1, 0x9f, 0x92, 0xe1, 0x9e, 0x8f, 0xe1, 0x9f, 0x92, 0xe1, 0x9e, 0x9a, 0xe1, 0x9e, 0xb8}, breakOffsets: []int{0, 18}, comment: "÷ [0.2] KHMER LETTER SA (LinkingConsonant) × [9.0] KHMER SIGN COENG (Extend_ConjunctLinker) × [9.3] KHMER LETTER TA (LinkingConsonant) × [9.0] KHMER SIGN COENG (Extend_ConjunctLinker) × [9.3] KHMER LETTER RO (LinkingConsonant) × [9.0] KHMER VOWEL SIGN II (Extend_ConjunctExtendermConjunctLinker) ÷ [0.3]"},
+	{lineNo: 789, input: []byte{0xe1, 0xac, 0xa6, 0xe1, 0xac, 0x97, 0xe1, 0xad, 0x84, 0xe1, 0xac, 0x93}, breakOffsets: []int{0, 3, 12}, comment: "÷ [0.2] BALINESE LETTER NA (LinkingConsonant) ÷ [999.0] BALINESE LETTER NGA (LinkingConsonant) × [9.0] BALINESE ADEG ADEG (Extend_ConjunctLinker) × [9.3] BALINESE LETTER KA (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 790, input: []byte{0xe1, 0xac, 0xa7, 0xe1, 0xac, 0x93, 0xe1, 0xad, 0x84, 0xe1, 0xac, 0x8b, 0xe1, 0xac, 0x8b, 0xe1, 0xac, 0x84}, breakOffsets: []int{0, 3, 12, 18}, comment: "÷ [0.2] BALINESE LETTER PA (LinkingConsonant) ÷ [999.0] BALINESE LETTER KA (LinkingConsonant) × [9.0] BALINESE ADEG ADEG (Extend_ConjunctLinker) × [9.3] BALINESE LETTER RA REPA (LinkingConsonant) ÷ [999.0] BALINESE LETTER RA REPA (LinkingConsonant) × [9.1] BALINESE SIGN BISAH (SpacingMark) ÷ [0.3]"},
+	{lineNo: 791, input: []byte{0xe1, 0x9e, 0x95, 0xe1, 0x9f, 0x92, 0xe1, 0x9e, 0xaf, 0xe1, 0x9e, 0x98}, breakOffsets: []int{0, 9, 12}, comment: "÷ [0.2] KHMER LETTER PHA (LinkingConsonant) × [9.0] KHMER SIGN COENG (Extend_ConjunctLinker) × [9.3] KHMER INDEPENDENT VOWEL QE (LinkingConsonant) ÷ [999.0] KHMER LETTER MO (LinkingConsonant) ÷ [0.3]"},
+	{lineNo: 792, input: []byte{0xe1, 0x9e, 0xa0, 0xe1, 0x9f, 0x92, 0xe1, 0x9e, 0xab, 0xe1, 0x9e, 0x91, 0xe1, 0x9f, 0x90, 0xe1, 0x9e, 0x99}, breakOffsets: []int{0, 9, 15, 18}, comment: "÷ [0.2] KHMER LETTER HA (LinkingConsonant) × [9.0] KHMER SIGN COENG (Extend_ConjunctLinker) × [9.3] KHMER INDEPENDENT VOWEL RY (LinkingConsonant) ÷ [999.0] KHMER LETTER TO (LinkingConsonant) × [9.0] KHMER SIGN SAMYOK SANNYA (Extend_ConjunctExtendermConjunctLinker) ÷ [999.0] KHMER LETTER YO (LinkingConsonant) ÷ [0.3]"},
+}
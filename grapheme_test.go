@@ -0,0 +1,107 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGraphemeConformance(t *testing.T) {
+	if len(graphemeTests) == 0 {
+		t.Fatal("no generated grapheme test cases")
+	}
+
+	for _, tc := range graphemeTests {
+		got := []int{0} // the test data has a break at sot
+		for pos := 0; pos < len(tc.input); {
+			pos += nextGrapheme(tc.input[pos:])
+			got = append(got, pos)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.breakOffsets) {
+			t.Errorf("line %d: input=%q got=%v want=%v %s", tc.lineNo, tc.input, got, tc.breakOffsets, tc.comment)
+		}
+	}
+}
+
+func TestGraphemeBoundaries(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"space and combining mark", "a ̈b", []string{"a ̈b"}},
+		{"space and combining mark, then space", "a ̈ b", []string{"a ̈ ", "b"}},
+		{"space and virama", "क ्ष", []string{"क ्ष"}},
+		{"prepend", "۝가", []string{"۝가"}},
+		{"prepend and regional indicator", "۝🇦", []string{"۝🇦"}},
+		{"mandatory", "ä\nb", []string{"ä\n", "b"}},
+		{"unaffected", "hello world", []string{"hello ", "world"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DefaultOptions.WithGraphemeBoundaries(true)
+			got := segments(tt.input, options)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			gotR := segments(tt.input, options.WithRuleset(defaultRuleset))
+			if fmt.Sprint(gotR) != fmt.Sprint(tt.want) {
+				t.Fatalf("ruleset: got %q, want %q", gotR, tt.want)
+			}
+		})
+	}
+}
+
+// TestGraphemeBoundaries_Corpus checks, over both conformance corpora, that
+// breaks are only on grapheme cluster boundaries, and are otherwise those of
+// the default algorithm.
+func TestGraphemeBoundaries_Corpus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping conformance test in short mode")
+	}
+
+	options := DefaultOptions.WithGraphemeBoundaries(true)
+	inputs := make([][]byte, 0, len(graphemeTests)+len(conformanceTests))
+	for _, tc := range graphemeTests {
+		inputs = append(inputs, tc.input)
+	}
+	for _, tc := range conformanceTests {
+		inputs = append(inputs, tc.input)
+	}
+
+	suppressed := 0
+	for _, input := range inputs {
+		clusters := graphemeCursor[[]byte]{data: input}
+		boundaries := map[int]bool{}
+		for pos := 0; pos < len(input); {
+			pos += nextGrapheme(input[pos:])
+			boundaries[pos] = true
+		}
+
+		want := map[int]breakKind{}
+		offsets, kinds := rulesetBreaks(input, &DefaultOptions)
+		for i, offset := range offsets {
+			if boundaries[offset] {
+				want[offset] = kinds[i]
+			} else {
+				suppressed++
+			}
+		}
+
+		got, gotKinds := rulesetBreaks(input, &options)
+		if len(got) != len(want) {
+			t.Fatalf("input=%q got=%v want=%v", input, got, want)
+		}
+		for i, offset := range got {
+			if !clusters.isBoundary(offset) {
+				t.Fatalf("input=%q: break at %d is within a grapheme cluster", input, offset)
+			}
+			if kind, ok := want[offset]; !ok || kind != gotKinds[i] {
+				t.Fatalf("input=%q: got break %d (%d), want %v", input, offset, gotKinds[i], want)
+			}
+		}
+	}
+	if suppressed == 0 {
+		t.Fatal("expected some breaks within grapheme clusters to be suppressed")
+	}
+}
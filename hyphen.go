@@ -0,0 +1,51 @@
+package uax14
+
+import "unicode/utf8"
+
+// hyphen is the kind of hyphen directly before a break opportunity.
+type hyphen uint8
+
+const (
+	noHyphen hyphen = iota
+	// softHyphen is U+00AD SOFT HYPHEN, invisible unless broken
+	softHyphen
+	// visibleHyphen is a hyphen or dash of class HY or HH, or of class HH
+	// in the trie, reassigned to BA
+	visibleHyphen
+	// repeatedHyphen is U+2010 HYPHEN, which some conventions repeat at the
+	// start of the next line
+	repeatedHyphen
+)
+
+// hyphenOf returns the kind of hyphen at the end of segment, which must end
+// at a break opportunity.
+func hyphenOf[T ~string | ~[]byte](segment T, options *Options) hyphen {
+	r := decodeLastRune(segment)
+	if r == '\u00AD' {
+		return softHyphen
+	}
+	if r == utf8.RuneError {
+		return noHyphen
+	}
+	p, _ := lookupPropertyWith(segment[len(segment)-utf8.RuneLen(r):], options.overrides)
+
+	switch {
+	case p.is(_HH) && r == '\u2010':
+		return repeatedHyphen
+	case p.is(_HY|_HH) || reassignedHyphen(r, p):
+		return visibleHyphen
+	}
+	return noHyphen
+}
+
+// reassignedHyphen reports whether r, of class p, is of class HH in the
+// trie, but reassigned to BA. Several such characters were BA before
+// Unicode 15.1, so a tailoring which reassigns them leaves them visible
+// hyphens.
+func reassignedHyphen(r rune, p property) bool {
+	if !p.is(_BA) {
+		return false
+	}
+	q, _ := lookupProperty(string(r))
+	return q.is(_HH)
+}
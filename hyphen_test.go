@@ -0,0 +1,85 @@
+package uax14
+
+import "testing"
+
+func TestIterator_Hyphens(t *testing.T) {
+	type seg struct {
+		text       string
+		hyphenated bool
+		after      bool
+		repeat     bool
+	}
+	tests := []struct {
+		name    string
+		in      string
+		options Options
+		want    []seg
+	}{
+		{
+			name: "soft hyphen",
+			in:   "hy\u00ADphen",
+			want: []seg{{"hy\u00AD", true, false, false}, {"phen", false, false, false}},
+		},
+		{
+			name: "hyphen-minus",
+			in:   "well-known",
+			want: []seg{{"well-", false, true, false}, {"known", false, false, false}},
+		},
+		{
+			name: "hyphen",
+			in:   "czarno\u2010biały",
+			want: []seg{{"czarno\u2010", false, true, true}, {"biały", false, false, false}},
+		},
+		{
+			name:    "hyphen reassigned to BA",
+			in:      "czarno\u2010biały",
+			options: DefaultOptions.WithClassOverrides(map[rune]Class{'\u2010': BA}),
+			want:    []seg{{"czarno\u2010", false, true, false}, {"biały", false, false, false}},
+		},
+		{
+			name: "en dash",
+			in:   "1990\u20132000",
+			want: []seg{{"1990\u2013", false, true, false}, {"2000", false, false, false}},
+		},
+		{
+			name:    "en dash reassigned to BA",
+			in:      "a\u2013b",
+			options: DefaultOptions.WithClassOverrides(map[rune]Class{'\u2013': BA}),
+			want:    []seg{{"a\u2013", false, true, false}, {"b", false, false, false}},
+		},
+		{
+			name: "space after hyphen",
+			in:   "a- b",
+			want: []seg{{"a- ", false, false, false}, {"b", false, false, false}},
+		},
+		{
+			name: "other BA",
+			in:   "a|b",
+			want: []seg{{"a|", false, false, false}, {"b", false, false, false}},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{tt.options, tt.options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				iter := NewIterator(tt.in)
+				iter.SetOptions(options)
+				var got []seg
+				for iter.Next() {
+					got = append(got, seg{iter.Current(), iter.Hyphenated(), iter.AfterHyphen(), iter.RepeatHyphen()})
+					if iter.Hyphenated() && !iter.CanBreak() {
+						t.Fatalf("segment %q: hyphenated break should be an opportunity", iter.Current())
+					}
+				}
+				if len(got) != len(tt.want) {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+				for i := range got {
+					if got[i] != tt.want[i] {
+						t.Fatalf("got %v, want %v", got, tt.want)
+					}
+				}
+			})
+		}
+	}
+}
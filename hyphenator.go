@@ -0,0 +1,300 @@
+package uax14
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Hyphenator finds hyphenation points within words, using Liang's algorithm
+// and the patterns of TeX, such as those of the hyph-utf8 project. A
+// Hyphenator is immutable, and safe to share across goroutines.
+//
+// Use [ParseHyphenator] to load patterns, and [Options.WithHyphenator] to
+// add hyphenation points to the break opportunities of an [Iterator].
+//
+// See https://tug.org/docs/liang/.
+type Hyphenator struct {
+	// nodes is a trie of patterns; nodes[0] is the root
+	nodes []hyphenNode
+	// exceptions are hyphenation points by lowercase word, as rune offsets
+	exceptions map[string][]int
+	// leftMin and rightMin are the minimum runes before and after a point
+	leftMin, rightMin int
+}
+
+type hyphenNode struct {
+	next map[rune]int32
+	// levels are the levels of the pattern ending here, before each of its
+	// letters and after the last; nil if no pattern ends here
+	levels []uint8
+}
+
+// ParseHyphenator parses hyphenation patterns, and optionally a list of
+// exceptions, which may be nil.
+//
+// Patterns are separated by white space, such as 1na, hy3ph or .ach4, where
+// digits are levels between letters, odd levels allow hyphenation and even
+// levels prohibit it, and . is the start or end of a word. Exceptions are
+// words with hyphens at each hyphenation point, such as ta-ble or project,
+// and override the patterns for those words. Both may be plain lists, as in
+// the .pat.txt and .hyp.txt files of hyph-utf8, or TeX source with
+// \patterns{...} and \hyphenation{...}; % begins a comment.
+//
+// The minimum fragments are two letters before a hyphenation point and three
+// after, as for English in TeX; use [Hyphenator.WithMinimums] to change
+// them.
+func ParseHyphenator(patterns, exceptions io.Reader) (*Hyphenator, error) {
+	h := &Hyphenator{
+		nodes:      []hyphenNode{{}},
+		exceptions: map[string][]int{},
+		leftMin:    2,
+		rightMin:   3,
+	}
+	if err := h.parse(patterns, false); err != nil {
+		return nil, err
+	}
+	if exceptions != nil {
+		if err := h.parse(exceptions, true); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// WithMinimums returns a copy of h, hyphenating only where there are at
+// least left letters before a hyphenation point, and right letters after it,
+// as \lefthyphenmin and \righthyphenmin in TeX.
+func (h *Hyphenator) WithMinimums(left, right int) *Hyphenator {
+	c := *h
+	c.leftMin = max(left, 1)
+	c.rightMin = max(right, 1)
+	return &c
+}
+
+// parse reads the patterns or exceptions of r.
+func (h *Hyphenator) parse(r io.Reader, exceptions bool) error {
+	inExceptions := exceptions
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text, _, _ := strings.Cut(scanner.Text(), "%")
+		for _, field := range strings.Fields(text) {
+			switch {
+			case strings.HasPrefix(field, `\patterns`):
+				inExceptions = false
+				field = strings.TrimPrefix(field, `\patterns`)
+			case strings.HasPrefix(field, `\hyphenation`):
+				inExceptions = true
+				field = strings.TrimPrefix(field, `\hyphenation`)
+			case strings.HasPrefix(field, `\`):
+				return fmt.Errorf("line %d: unsupported command %q", line, field)
+			}
+			field = strings.TrimPrefix(field, "{")
+			closed := strings.HasSuffix(field, "}")
+			field = strings.TrimSuffix(field, "}")
+
+			if field != "" {
+				var err error
+				if inExceptions {
+					err = h.addException(field)
+				} else {
+					err = h.addPattern(field)
+				}
+				if err != nil {
+					return fmt.Errorf("line %d: %w", line, err)
+				}
+			}
+			if closed {
+				inExceptions = exceptions
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// addPattern adds a pattern such as hy3ph to the trie.
+func (h *Hyphenator) addPattern(pattern string) error {
+	var letters []rune
+	levels := []uint8{0}
+	digit := false
+	for _, r := range pattern {
+		switch {
+		case r >= '0' && r <= '9':
+			if digit {
+				return fmt.Errorf("invalid pattern %q: levels are single digits", pattern)
+			}
+			levels[len(levels)-1] = uint8(r - '0')
+			digit = true
+		default:
+			letters = append(letters, unicode.ToLower(r))
+			levels = append(levels, 0)
+			digit = false
+		}
+	}
+	if len(letters) == 0 {
+		return fmt.Errorf("invalid pattern %q: no letters", pattern)
+	}
+	for i, r := range letters {
+		if r == '.' && i != 0 && i != len(letters)-1 {
+			return fmt.Errorf("invalid pattern %q: . must begin or end it", pattern)
+		}
+	}
+
+	node := int32(0)
+	for _, r := range letters {
+		next, ok := h.nodes[node].next[r]
+		if !ok {
+			if h.nodes[node].next == nil {
+				h.nodes[node].next = map[rune]int32{}
+			}
+			next = int32(len(h.nodes))
+			h.nodes = append(h.nodes, hyphenNode{})
+			h.nodes[node].next[r] = next
+		}
+		node = next
+	}
+	if h.nodes[node].levels != nil {
+		return fmt.Errorf("duplicate pattern %q", pattern)
+	}
+	h.nodes[node].levels = levels
+	return nil
+}
+
+// addException adds an exception such as ta-ble.
+func (h *Hyphenator) addException(exception string) error {
+	var word strings.Builder
+	var points []int
+	n := 0
+	last := '-'
+	for _, r := range exception {
+		if r == '-' {
+			if last == '-' {
+				return fmt.Errorf("invalid exception %q", exception)
+			}
+			points = append(points, n)
+		} else {
+			word.WriteRune(unicode.ToLower(r))
+			n++
+		}
+		last = r
+	}
+	if last == '-' {
+		return fmt.Errorf("invalid exception %q", exception)
+	}
+	h.exceptions[word.String()] = points
+	return nil
+}
+
+// Hyphenate returns the hyphenation points of word, as byte offsets at which
+// it may be broken with a hyphen, in increasing order. Case is ignored.
+func (h *Hyphenator) Hyphenate(word string) []int {
+	return hyphenate(h, word)
+}
+
+func hyphenate[T ~string | ~[]byte](h *Hyphenator, word T) []int {
+	// The word, lowercase, between . for its start and end
+	w := make([]rune, 0, len(word)+2)
+	offsets := make([]int, 0, len(word))
+	w = append(w, '.')
+	for i, r := range string(word) {
+		w = append(w, unicode.ToLower(r))
+		offsets = append(offsets, i)
+	}
+	w = append(w, '.')
+
+	n := len(offsets)
+	if n < h.leftMin+h.rightMin {
+		return nil
+	}
+
+	var points []int
+	if exception, ok := h.exceptions[string(w[1:len(w)-1])]; ok {
+		for _, i := range exception {
+			if i >= h.leftMin && n-i >= h.rightMin {
+				points = append(points, offsets[i])
+			}
+		}
+		return points
+	}
+
+	// levels[i] is the level before w[i]
+	levels := make([]uint8, len(w)+1)
+	for start := range w {
+		node := int32(0)
+		for j := start; j < len(w); j++ {
+			next, ok := h.nodes[node].next[w[j]]
+			if !ok {
+				break
+			}
+			node = next
+			for k, level := range h.nodes[node].levels {
+				levels[start+k] = max(levels[start+k], level)
+			}
+		}
+	}
+
+	// The point before the i'th rune of word is before w[i+1]
+	for i := h.leftMin; i <= n-h.rightMin; i++ {
+		if levels[i+1]%2 == 1 {
+			points = append(points, offsets[i])
+		}
+	}
+	return points
+}
+
+// WithHyphenator returns a copy of options, which adds the hyphenation points
+// found by h within words to the break opportunities of
+// an [Iterator], as hyphenated breaks; see [Iterator.Hyphenated]. A nil h
+// disables hyphenation.
+func (options Options) WithHyphenator(h *Hyphenator) Options {
+	options.hyphenator = h
+	return options
+}
+
+// hyphenationPoints returns the hyphenation points within the words of
+// segment, as byte offsets in increasing order. A word is a run of AL and
+// HL letters, with any combining marks; runs which include digits, symbols
+// or complex context (SA), such as abc123 or user@host, are left alone.
+func hyphenationPoints[T ~string | ~[]byte](segment T, options *Options) []int {
+	var points []int
+	start := -1 // start of the current run, if any
+	letters := true
+	pos := 0
+	for {
+		var p property
+		var w int
+		if pos < len(segment) {
+			p, w = lookupPropertyWith(segment[pos:], options.overrides)
+		}
+		inRun := w > 0 && (p.is(_AL|_HL|_NU) || start >= 0 && p.is(_CM|_ZWJ))
+		if !inRun && start >= 0 {
+			if letters {
+				word := segment[start:pos]
+				for _, point := range hyphenate(options.hyphenator, word) {
+					// Never separate a combining mark from its base
+					if q, _ := lookupPropertyWith(word[point:], options.overrides); !q.is(_CM | _ZWJ) {
+						points = append(points, start+point)
+					}
+				}
+			}
+			start = -1
+		}
+		if w == 0 {
+			return points
+		}
+		if inRun {
+			if start < 0 {
+				start = pos
+				letters = true
+			}
+			if p.is(_AL|_HL) && !unicode.IsLetter(decodeRune(segment[pos:])) || p.is(_NU|_SA) {
+				letters = false
+			}
+		}
+		pos += w
+	}
+}
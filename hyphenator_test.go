@@ -0,0 +1,173 @@
+package uax14
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func loadHyphenator(t *testing.T) *Hyphenator {
+	t.Helper()
+	f, err := os.Open("testdata/hyph-en.tex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	h, err := ParseHyphenator(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+// hyphenated returns word with a hyphen at each of its hyphenation points.
+func hyphenated(h *Hyphenator, word string) string {
+	var b strings.Builder
+	last := 0
+	for _, point := range h.Hyphenate(word) {
+		b.WriteString(word[last:point])
+		b.WriteByte('-')
+		last = point
+	}
+	b.WriteString(word[last:])
+	return b.String()
+}
+
+func TestHyphenator_Hyphenate(t *testing.T) {
+	h := loadHyphenator(t)
+
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"hyphenation", "hy-phen-ation"},
+		{"Hyphenation", "Hy-phen-ation"},
+		{"project", "pro-ject"},
+		// Exceptions
+		{"table", "ta-ble"},
+		{"Present", "Pre-sent"},
+		// Too short
+		{"hyph", "hyph"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := hyphenated(h, tt.word); got != tt.want {
+			t.Errorf("Hyphenate(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+
+	// Minimums
+	if got := hyphenated(h.WithMinimums(3, 3), "hyphenation"); got != "hyphen-ation" {
+		t.Errorf("with minimums 3, 3: got %q", got)
+	}
+	if got := hyphenated(h.WithMinimums(1, 4), "project"); got != "pro-ject" {
+		t.Errorf("with minimums 1, 4: got %q", got)
+	}
+	if got := hyphenated(h.WithMinimums(1, 5), "project"); got != "project" {
+		t.Errorf("with minimums 1, 5: got %q", got)
+	}
+}
+
+func TestHyphenator_PlainLists(t *testing.T) {
+	// The .pat.txt and .hyp.txt files of hyph-utf8, with minimums of 2 as
+	// for German
+	patterns := "l1b\nn1t\nn1n\n"
+	exceptions := "Sil-ben-tren-nung-en\n"
+	h, err := ParseHyphenator(strings.NewReader(patterns), strings.NewReader(exceptions))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h = h.WithMinimums(2, 2)
+
+	if got := hyphenated(h, "Silbentrennung"); got != "Sil-ben-tren-nung" {
+		t.Errorf("got %q", got)
+	}
+	if got := hyphenated(h, "Silbentrennungen"); got != "Sil-ben-tren-nung-en" {
+		t.Errorf("got %q", got)
+	}
+	if got := hyphenated(h, "Kälbenstunde"); got != "Käl-benstunde" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestHyphenator_ParseErrors(t *testing.T) {
+	tests := []struct {
+		patterns   string
+		exceptions string
+	}{
+		{patterns: "a12b"},
+		{patterns: "a.b"},
+		{patterns: "12"},
+		{patterns: "ab1 a1b ab1"},
+		{patterns: `\message{hello}`},
+		{patterns: "1a", exceptions: "ta--ble"},
+		{patterns: "1a", exceptions: "-table"},
+		{patterns: "1a", exceptions: "table-"},
+	}
+	for _, tt := range tests {
+		_, err := ParseHyphenator(strings.NewReader(tt.patterns), strings.NewReader(tt.exceptions))
+		if err == nil {
+			t.Errorf("ParseHyphenator(%q, %q) should fail", tt.patterns, tt.exceptions)
+		}
+	}
+}
+
+func TestIterator_Hyphenator(t *testing.T) {
+	h := loadHyphenator(t)
+	options := DefaultOptions.WithHyphenator(h)
+
+	tests := []struct {
+		name       string
+		in         string
+		want       []string
+		hyphenated []bool
+	}{
+		{
+			name:       "words",
+			in:         "Hyphenation project.",
+			want:       []string{"Hy", "phen", "ation ", "pro", "ject."},
+			hyphenated: []bool{true, true, false, true, false},
+		},
+		{
+			name:       "soft hyphen",
+			in:         "hyphen\u00ADation",
+			want:       []string{"hy", "phen\u00AD", "ation"},
+			hyphenated: []bool{true, true, false},
+		},
+		{
+			name:       "not within identifiers",
+			in:         "hyphenation1234 user@hyphenation",
+			want:       []string{"hyphenation1234 ", "user@hyphenation"},
+			hyphenated: []bool{false, false},
+		},
+		{
+			name:       "combining marks",
+			in:         "pro\u0301ject",
+			want:       []string{"pro\u0301", "ject"},
+			hyphenated: []bool{true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{options, options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				iter := NewIterator(tt.in)
+				iter.SetOptions(options)
+				var got []string
+				var hyphenated []bool
+				for iter.Next() {
+					got = append(got, iter.Current())
+					hyphenated = append(hyphenated, iter.Hyphenated())
+				}
+				if len(got) != len(tt.want) {
+					t.Fatalf("got %q %v, want %q %v", got, hyphenated, tt.want, tt.hyphenated)
+				}
+				for i := range got {
+					if got[i] != tt.want[i] || hyphenated[i] != tt.hyphenated[i] {
+						t.Fatalf("got %q %v, want %q %v", got, hyphenated, tt.want, tt.hyphenated)
+					}
+				}
+			})
+		}
+	}
+}
@@ -26,8 +26,19 @@ const (
 	eastAstionWidthURL = "https://unicode.org/Public/" + unicodeVersion + "/ucd/EastAsianWidth.txt"
 	lineBreakTestURL   = "https://unicode.org/Public/" + unicodeVersion + "/ucd/auxiliary/LineBreakTest.txt"
 	outputFilename     = "../../trie.go"
-	outputTestFilename = "../../unicode_tests.go"
+	outputTestFilename = "../../unicode_conformance_test.go"
 	cacheDir           = "cache"
+
+	graphemeBreakURL            = "https://unicode.org/Public/" + unicodeVersion + "/ucd/auxiliary/GraphemeBreakProperty.txt"
+	emojiDataURL                = "https://unicode.org/Public/" + unicodeVersion + "/ucd/emoji/emoji-data.txt"
+	derivedCorePropertiesURL    = "https://unicode.org/Public/" + unicodeVersion + "/ucd/DerivedCoreProperties.txt"
+	graphemeBreakTestURL        = "https://unicode.org/Public/" + unicodeVersion + "/ucd/auxiliary/GraphemeBreakTest.txt"
+	graphemeOutputFilename      = "../../grapheme_trie.go"
+	graphemeTestsOutputFilename = "../../grapheme_conformance_test.go"
+
+	widthOutputFilename = "../../width_trie.go"
+
+	syllableOutputFilename = "../../syllable_tables.go"
 )
 
 var versionRE = regexp.MustCompile(`LineBreak-([0-9]+(?:\.[0-9]+)*)\.txt`)
@@ -70,6 +81,10 @@ func main() {
 	quoteCategoryRecords := selectQuoteCategoryRecords(categoryRecords)
 	combiningMarks := selectCombiningMarks(categoryRecords)
 
+	if err := generateSyllableTables(records, categoryRecords); err != nil {
+		fail(err)
+	}
+
 	records = resolveLineBreakClasses(records, combiningMarks)
 
 	eastAsianWidthContent, err := loadData(eastAstionWidthURL)
@@ -104,7 +119,7 @@ func main() {
 	if err != nil {
 		fail(err)
 	}
-	testSrc, err := generateConformanceTestsSource(tests, lineBreakTestURL)
+	testSrc, err := generateConformanceTestsSource(tests, lineBreakTestURL, "lineBreakTests", "conformanceTests")
 	if err != nil {
 		fail(err)
 	}
@@ -115,6 +130,444 @@ func main() {
 	if err := os.WriteFile(outputTestFilename, testFormatted, 0o644); err != nil {
 		fail(fmt.Errorf("write %s: %w", outputTestFilename, err))
 	}
+
+	if err := generateGraphemes(); err != nil {
+		fail(err)
+	}
+	if err := generateWidths(); err != nil {
+		fail(err)
+	}
+}
+
+// generateGraphemes generates the UAX #29 grapheme cluster boundary trie,
+// and its conformance test cases.
+func generateGraphemes() error {
+	var records []record
+	for _, source := range []struct {
+		url    string
+		filter func([]record) []record
+	}{
+		{graphemeBreakURL, selectGraphemeBreakRecords},
+		{emojiDataURL, selectExtendedPictographicRecords},
+		{derivedCorePropertiesURL, selectIndicConjunctBreakRecords},
+	} {
+		content, err := loadData(source.url)
+		if err != nil {
+			return err
+		}
+		parsed, err := parseLineBreak(content)
+		if err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(source.url), err)
+		}
+		records = append(records, source.filter(parsed)...)
+	}
+
+	src, err := generateGraphemeTrieSource(records, graphemeBreakURL, emojiDataURL, derivedCorePropertiesURL)
+	if err != nil {
+		return err
+	}
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format grapheme trie file: %w", err)
+	}
+	if err := os.WriteFile(graphemeOutputFilename, formatted, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", graphemeOutputFilename, err)
+	}
+
+	testContent, err := loadData(graphemeBreakTestURL)
+	if err != nil {
+		return err
+	}
+	tests, err := parseLineBreakTests(testContent)
+	if err != nil {
+		return err
+	}
+	testSrc, err := generateConformanceTestsSource(tests, graphemeBreakTestURL, "graphemeTests", "")
+	if err != nil {
+		return err
+	}
+	testFormatted, err := format.Source(testSrc)
+	if err != nil {
+		return fmt.Errorf("format grapheme test file: %w", err)
+	}
+	if err := os.WriteFile(graphemeTestsOutputFilename, testFormatted, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", graphemeTestsOutputFilename, err)
+	}
+	return nil
+}
+
+func selectGraphemeBreakRecords(records []record) []record {
+	out := make([]record, 0, len(records))
+	for _, rec := range records {
+		// Regional_Indicator is abbreviated, to match the line break class
+		if rec.class == "Regional_Indicator" {
+			rec.class = "RI"
+		}
+		out = append(out, rec)
+	}
+	return out
+}
+
+func selectExtendedPictographicRecords(records []record) []record {
+	out := make([]record, 0, 512)
+	for _, rec := range records {
+		if rec.class == "Extended_Pictographic" {
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "ExtPict"})
+		}
+	}
+	return out
+}
+
+// selectIndicConjunctBreakRecords selects InCB values, for GB9c.
+func selectIndicConjunctBreakRecords(records []record) []record {
+	out := make([]record, 0, 512)
+	for _, rec := range records {
+		name, value, ok := strings.Cut(rec.class, ";")
+		if !ok || strings.TrimSpace(name) != "InCB" {
+			continue
+		}
+		out = append(out, record{lo: rec.lo, hi: rec.hi, class: "InCB" + strings.TrimSpace(value)})
+	}
+	return out
+}
+
+func generateGraphemeTrieSource(records []record, sourceLabels ...string) ([]byte, error) {
+	classes := uniqueClasses(records)
+
+	iotasByClass := map[string]uint64{}
+	for i, c := range classes {
+		iotasByClass[c] = 1 << i
+	}
+
+	trie := triegen.NewTrie("grapheme")
+	runeValues := map[rune]uint64{}
+	for _, rec := range records {
+		v := iotasByClass[rec.class]
+		for r := rec.lo; r <= rec.hi; r++ {
+			if r >= 0xD800 && r <= 0xDFFF {
+				continue
+			}
+			runeValues[r] |= v
+		}
+	}
+	for r, v := range runeValues {
+		trie.Insert(r, v)
+	}
+
+	buf := bytes.Buffer{}
+	fmt.Fprintln(&buf, "package uax14")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Code generated by internal/gen; DO NOT EDIT.")
+	for _, label := range sourceLabels {
+		fmt.Fprintf(&buf, "// Source: %s\n", label)
+	}
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// graphemeProperty is a set of UAX #29 grapheme cluster break properties.")
+	fmt.Fprintln(&buf, "type graphemeProperty uint32")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "const (")
+	for i, c := range classes {
+		if i == 0 {
+			fmt.Fprintf(&buf, "\t_g%s graphemeProperty = 1 << iota\n", c)
+		} else {
+			fmt.Fprintf(&buf, "\t_g%s\n", c)
+		}
+	}
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+
+	_, err := triegen.Gen(&buf, "grapheme", []*triegen.Trie{trie}, triegen.TypeName("graphemeProperty"))
+	if err != nil {
+		return nil, err
+	}
+
+	b := buf.Bytes()
+	typename := "graphemeTrie"
+	b = bytes.ReplaceAll(b, []byte("type "+typename+" struct"), []byte("// type "+typename+" struct"))
+	b = bytes.ReplaceAll(b, []byte("// lookup returns"), []byte("// lookupGrapheme returns"))
+	b = bytes.ReplaceAll(b, []byte("(t *"+typename+") lookup(s []byte)"), []byte("lookupGrapheme[T ~string | ~[]byte](s T)"))
+	b = bytes.ReplaceAll(b, []byte("// lookupValue determines"), []byte("// lookupGraphemeValue determines"))
+	b = bytes.ReplaceAll(b, []byte("(t *"+typename+") lookupValue"), []byte("lookupGraphemeValue"))
+	b = bytes.ReplaceAll(b, []byte("t.lookupValue("), []byte("lookupGraphemeValue("))
+
+	return b, nil
+}
+
+// generateWidths generates the trie of properties for display width in
+// terminal columns, from the same Unicode version as the line break trie.
+func generateWidths() error {
+	var records []record
+	for _, source := range []struct {
+		url    string
+		filter func([]record) []record
+	}{
+		{eastAstionWidthURL, selectWidthRecords},
+		{generalCategoryURL, selectZeroWidthRecords},
+		{emojiDataURL, selectEmojiRecords},
+		{graphemeBreakURL, selectConjoiningJamoRecords},
+	} {
+		content, err := loadData(source.url)
+		if err != nil {
+			return err
+		}
+		parsed, err := parseLineBreak(content)
+		if err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(source.url), err)
+		}
+		records = append(records, source.filter(parsed)...)
+	}
+
+	src, err := generateWidthTrieSource(records, eastAstionWidthURL, generalCategoryURL, emojiDataURL, graphemeBreakURL)
+	if err != nil {
+		return err
+	}
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format width trie file: %w", err)
+	}
+	if err := os.WriteFile(widthOutputFilename, formatted, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", widthOutputFilename, err)
+	}
+	return nil
+}
+
+// selectWidthRecords selects East_Asian_Width Wide and Fullwidth, which are
+// two columns, and Ambiguous, which is one or two.
+func selectWidthRecords(records []record) []record {
+	out := make([]record, 0, len(records))
+	for _, rec := range records {
+		switch rec.class {
+		case "W", "F":
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "Wide"})
+		case "A":
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "Ambiguous"})
+		}
+	}
+	return out
+}
+
+// selectZeroWidthRecords selects nonspacing and enclosing marks and format
+// characters, which take no columns, and controls. U+00AD SOFT HYPHEN, a
+// format character, is one column, as by wcwidth, since terminals display
+// it.
+func selectZeroWidthRecords(records []record) []record {
+	const softHyphen = 0x00AD
+	out := make([]record, 0, 1024)
+	for _, rec := range records {
+		switch rec.class {
+		case "Mn", "Me", "Cf":
+			if rec.lo <= softHyphen && softHyphen <= rec.hi {
+				if rec.lo < softHyphen {
+					out = append(out, record{lo: rec.lo, hi: softHyphen - 1, class: "Zero"})
+				}
+				if softHyphen < rec.hi {
+					out = append(out, record{lo: softHyphen + 1, hi: rec.hi, class: "Zero"})
+				}
+				continue
+			}
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "Zero"})
+		case "Cc":
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "Control"})
+		}
+	}
+	return out
+}
+
+// selectEmojiRecords selects Emoji, which is two columns when followed by
+// VS16, and Emoji_Presentation, which is two columns unless followed by
+// VS15.
+func selectEmojiRecords(records []record) []record {
+	out := make([]record, 0, 512)
+	for _, rec := range records {
+		switch rec.class {
+		case "Emoji":
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "Emoji"})
+		case "Emoji_Presentation":
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "EmojiPresentation"})
+		}
+	}
+	return out
+}
+
+// selectConjoiningJamoRecords selects Hangul medial vowels and final
+// consonants, which join the leading consonant of a syllable in its two
+// columns.
+func selectConjoiningJamoRecords(records []record) []record {
+	out := make([]record, 0, 8)
+	for _, rec := range records {
+		if rec.class != "V" && rec.class != "T" {
+			continue
+		}
+		if rec.lo >= 0x1100 && rec.hi <= 0x11FF || rec.lo >= 0xD7B0 && rec.hi <= 0xD7FF {
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "Zero"})
+		}
+	}
+	return out
+}
+
+func generateWidthTrieSource(records []record, sourceLabels ...string) ([]byte, error) {
+	classes := uniqueClasses(records)
+
+	iotasByClass := map[string]uint64{}
+	for i, c := range classes {
+		iotasByClass[c] = 1 << i
+	}
+
+	trie := triegen.NewTrie("width")
+	runeValues := map[rune]uint64{}
+	for _, rec := range records {
+		v := iotasByClass[rec.class]
+		for r := rec.lo; r <= rec.hi; r++ {
+			if r >= 0xD800 && r <= 0xDFFF {
+				continue
+			}
+			runeValues[r] |= v
+		}
+	}
+	for r, v := range runeValues {
+		trie.Insert(r, v)
+	}
+
+	buf := bytes.Buffer{}
+	fmt.Fprintln(&buf, "package uax14")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Code generated by internal/gen; DO NOT EDIT.")
+	for _, label := range sourceLabels {
+		fmt.Fprintf(&buf, "// Source: %s\n", label)
+	}
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// widthProperty is a set of properties for display width in terminal columns.")
+	fmt.Fprintln(&buf, "type widthProperty uint32")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "const (")
+	for i, c := range classes {
+		if i == 0 {
+			fmt.Fprintf(&buf, "\t_w%s widthProperty = 1 << iota\n", c)
+		} else {
+			fmt.Fprintf(&buf, "\t_w%s\n", c)
+		}
+	}
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+
+	_, err := triegen.Gen(&buf, "width", []*triegen.Trie{trie}, triegen.TypeName("widthProperty"))
+	if err != nil {
+		return nil, err
+	}
+
+	b := buf.Bytes()
+	typename := "widthTrie"
+	b = bytes.ReplaceAll(b, []byte("type "+typename+" struct"), []byte("// type "+typename+" struct"))
+	b = bytes.ReplaceAll(b, []byte("// lookup returns"), []byte("// lookupWidth returns"))
+	b = bytes.ReplaceAll(b, []byte("(t *"+typename+") lookup(s []byte)"), []byte("lookupWidth[T ~string | ~[]byte](s T)"))
+	b = bytes.ReplaceAll(b, []byte("// lookupValue determines"), []byte("// lookupWidthValue determines"))
+	b = bytes.ReplaceAll(b, []byte("(t *"+typename+") lookupValue"), []byte("lookupWidthValue"))
+	b = bytes.ReplaceAll(b, []byte("t.lookupValue("), []byte("lookupWidthValue("))
+
+	return b, nil
+}
+
+// syllableBlocks are the Myanmar and Khmer blocks, whose SA characters are
+// segmented into syllables.
+var syllableBlocks = []struct{ lo, hi rune }{
+	{0x1000, 0x109F},   // Myanmar
+	{0x1780, 0x17FF},   // Khmer
+	{0x19E0, 0x19FF},   // Khmer Symbols
+	{0xA9E0, 0xA9FF},   // Myanmar Extended-B
+	{0xAA60, 0xAA7F},   // Myanmar Extended-A
+	{0x116D0, 0x116FF}, // Myanmar Extended-C
+}
+
+// generateSyllableTables generates the tables of the syllable segmenter,
+// from the unresolved SA class of LineBreak.txt and General_Category.
+func generateSyllableTables(lineBreakRecords, categoryRecords []record) error {
+	letters := map[rune]bool{}
+	for _, rec := range categoryRecords {
+		if rec.class == "Lo" {
+			for r := rec.lo; r <= rec.hi; r++ {
+				letters[r] = true
+			}
+		}
+	}
+
+	var syllabic, bases []rune
+	for _, rec := range lineBreakRecords {
+		if rec.class != "SA" {
+			continue
+		}
+		for r := rec.lo; r <= rec.hi; r++ {
+			for _, block := range syllableBlocks {
+				if r < block.lo || r > block.hi {
+					continue
+				}
+				syllabic = append(syllabic, r)
+				if letters[r] {
+					bases = append(bases, r)
+				}
+			}
+		}
+	}
+	sort.Slice(syllabic, func(i, j int) bool { return syllabic[i] < syllabic[j] })
+	sort.Slice(bases, func(i, j int) bool { return bases[i] < bases[j] })
+
+	buf := bytes.Buffer{}
+	fmt.Fprintln(&buf, "package uax14")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Code generated by internal/gen; DO NOT EDIT.")
+	fmt.Fprintf(&buf, "// Source: %s\n", lineBreakURL)
+	fmt.Fprintf(&buf, "// Source: %s\n", generalCategoryURL)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, `import "unicode"`)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// syllabic are the characters of class SA in the Myanmar and Khmer blocks.")
+	writeRangeTable(&buf, "syllabic", syllabic)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// syllableBases are the letters of syllabic, of General_Category Lo.")
+	writeRangeTable(&buf, "syllableBases", bases)
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format syllable tables: %w", err)
+	}
+	if err := os.WriteFile(syllableOutputFilename, formatted, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", syllableOutputFilename, err)
+	}
+	return nil
+}
+
+// writeRangeTable writes a unicode.RangeTable of runes, in increasing
+// order, as name.
+func writeRangeTable(w io.Writer, name string, runes []rune) {
+	type span struct{ lo, hi rune }
+	var spans []span
+	for _, r := range runes {
+		if n := len(spans); n > 0 && spans[n-1].hi+1 == r {
+			spans[n-1].hi = r
+		} else {
+			spans = append(spans, span{r, r})
+		}
+	}
+
+	fmt.Fprintf(w, "var %s = &unicode.RangeTable{\n", name)
+	if len(spans) > 0 && spans[0].lo <= 0xFFFF {
+		fmt.Fprintln(w, "\tR16: []unicode.Range16{")
+		for _, s := range spans {
+			if s.hi <= 0xFFFF {
+				fmt.Fprintf(w, "\t\t{Lo: 0x%04X, Hi: 0x%04X, Stride: 1},\n", s.lo, s.hi)
+			}
+		}
+		fmt.Fprintln(w, "\t},")
+	}
+	if len(spans) > 0 && spans[len(spans)-1].hi > 0xFFFF {
+		fmt.Fprintln(w, "\tR32: []unicode.Range32{")
+		for _, s := range spans {
+			if s.lo > 0xFFFF {
+				fmt.Fprintf(w, "\t\t{Lo: 0x%X, Hi: 0x%X, Stride: 1},\n", s.lo, s.hi)
+			}
+		}
+		fmt.Fprintln(w, "\t},")
+	}
+	fmt.Fprintln(w, "}")
 }
 
 func loadData(sourceURL string) ([]byte, error) {
@@ -359,7 +812,8 @@ func generateTrieSource(records, quoteCategories, eastAsian, extPictUnassigned [
 	}
 	// Entries with only annotation bits (e.g. EPU without a LineBreak.txt entry)
 	// default to AL, matching the XX → AL resolution for unmapped code points.
-	annotationMask := iotasByClass["DC"] | iotasByClass["EA"] | iotasByClass["EPU"] | iotasByClass["PI"] | iotasByClass["PF"]
+	annotationMask := iotasByClass["AI"] | iotasByClass["CJ"] | iotasByClass["SA"] |
+		iotasByClass["DC"] | iotasByClass["EA"] | iotasByClass["EPU"] | iotasByClass["PI"] | iotasByClass["PF"]
 	for r, v := range runeValues {
 		if v&^annotationMask == 0 {
 			runeValues[r] = v | iotasByClass["AL"]
@@ -405,24 +859,25 @@ func generateTrieSource(records, quoteCategories, eastAsian, extPictUnassigned [
 	return b, nil
 }
 
-func generateConformanceTestsSource(tests []conformanceCase, sourceLabel string) ([]byte, error) {
+// generateConformanceTestsSource generates test cases as varName, in a
+// _test.go file; the conformanceTest type is declared in unicode_test.go.
+// Where slice is not empty, the cases are also assigned to that variable,
+// declared there, so that tests build without the generated file.
+func generateConformanceTestsSource(tests []conformanceCase, sourceLabel, varName, slice string) ([]byte, error) {
 	buf := bytes.Buffer{}
 	fmt.Fprintln(&buf, "package uax14")
 	fmt.Fprintln(&buf)
 	fmt.Fprintln(&buf, "// Code generated by internal/gen; DO NOT EDIT.")
 	fmt.Fprintf(&buf, "// Source: %s\n\n", sourceLabel)
-	fmt.Fprintln(&buf, "type conformanceTest struct {")
-	fmt.Fprintln(&buf, "\tlineNo       int")
-	fmt.Fprintln(&buf, "\tinput        []byte")
-	fmt.Fprintln(&buf, "\tbreakOffsets []int")
-	fmt.Fprintln(&buf, "\tcomment      string")
-	fmt.Fprintln(&buf, "}")
-	fmt.Fprintln(&buf)
-	fmt.Fprintf(&buf, "var conformanceTests = [%d]conformanceTest{\n", len(tests))
+	fmt.Fprintf(&buf, "var %s = [%d]conformanceTest{\n", varName, len(tests))
 	for _, tc := range tests {
 		fmt.Fprintf(&buf, "{lineNo: %d, input: %#v, breakOffsets: %#v, comment: %#v},\n", tc.lineNo, tc.input, tc.breakOffsets, tc.comment)
 	}
 	fmt.Fprintln(&buf, "}")
+	if slice != "" {
+		fmt.Fprintln(&buf)
+		fmt.Fprintf(&buf, "func init() {\n\t%s = %s[:]\n}\n", slice, varName)
+	}
 
 	b := buf.Bytes()
 	b = bytes.ReplaceAll(b, []byte("[]uint8{0x"), []byte("{0x"))
@@ -531,16 +986,23 @@ func selectCombiningMarks(categoryRecords []record) map[rune]bool {
 //
 //	AI/SG/XX → AL, CJ → NS, SA → CM (Mn/Mc) or AL (others).
 //
-// This eliminates the need for runtime class resolution.
+// This eliminates the need for runtime class resolution. AI, CJ and SA are
+// kept as annotation bits alongside the resolved class, for tailorings which
+// resolve them differently.
 func resolveLineBreakClasses(records []record, combiningMarks map[rune]bool) []record {
 	out := make([]record, 0, len(records))
 	for _, rec := range records {
 		switch rec.class {
-		case "AI", "SG", "XX":
+		case "AI":
+			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "AL"})
+			out = append(out, rec)
+		case "SG", "XX":
 			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "AL"})
 		case "CJ":
 			out = append(out, record{lo: rec.lo, hi: rec.hi, class: "NS"})
+			out = append(out, rec)
 		case "SA":
+			out = append(out, rec)
 			for r := rec.lo; r <= rec.hi; r++ {
 				if combiningMarks[r] {
 					out = append(out, record{lo: r, hi: r, class: "CM"})
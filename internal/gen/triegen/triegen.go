@@ -119,6 +119,9 @@ type builder struct {
 		NHandleBytes  int
 	}
 
+	// typeName, if set, replaces the type name of values and indexes.
+	typeName string
+
 	err error
 }
 
@@ -161,6 +164,15 @@ func Compact(c Compacter) Option {
 	}
 }
 
+// TypeName configures the trie generator to use the given type name for
+// values and indexes, rather than property.
+func TypeName(name string) Option {
+	return func(b *builder) error {
+		b.typeName = name
+		return nil
+	}
+}
+
 // Gen writes Go code for a shared trie lookup structure to w for the given
 // Tries. The generated trie type will be called nameTrie. newNameTrie(x) will
 // return the *nameTrie for tries[x]. A value can be looked up by using one of
@@ -329,6 +341,9 @@ func (b *builder) build() {
 		vmax = maxValue(t.root, vmax)
 	}
 	b.ValueType, b.ValueSize = getIntType(vmax)
+	if b.typeName != "" {
+		b.ValueType = b.typeName
+	}
 
 	// Compute all block allocations.
 	// TODO: first compute the ASCII blocks for all tries and then the other
@@ -358,6 +373,9 @@ func (b *builder) build() {
 		}
 	}
 	b.IndexType, b.IndexSize = getIntType(imax)
+	if b.typeName != "" {
+		b.IndexType = b.typeName
+	}
 }
 
 func maxValue(n *node, max uint64) uint64 {
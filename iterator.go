@@ -0,0 +1,348 @@
+package uax14
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// Iterator is a generic iterator over line break segments in strings or
+// byte slices. Each segment ends at a break, either mandatory or an
+// opportunity.
+type Iterator[T ~string | ~[]byte] struct {
+	data    T
+	pos     int
+	start   int
+	options Options
+	// boundary is the break after the current segment
+	boundary
+	// end is the end of the segment before any split by Overflow or at an
+	// inner break, whose remainder is returned by the next call to Next
+	end         int
+	endBoundary boundary
+	// inner are breaks before end, in increasing order, which the default
+	// rules do not find, such as hyphenation points
+	inner []innerBreak
+	// constraints are set by SetConstraints, and by technical tailorings
+	constraints constraints
+	// technical recognizes technical text, see [Options.WithTechnical]
+	technical technicalCursor[T]
+}
+
+// boundary describes a break.
+type boundary struct {
+	kind breakKind
+	// sep is the separator before a mandatory break
+	sep Separator
+	// hyphen is the hyphen before an opportunity
+	hyphen hyphen
+	// opportunity is the kind of an opportunity
+	opportunity Opportunity
+}
+
+// innerBreak is a break within a segment of the default rules, such as a
+// hyphenation point.
+type innerBreak struct {
+	pos         int
+	kind        breakKind
+	opportunity Opportunity
+}
+
+// NewIterator returns an iterator for the line break segments in data.
+// Iterate while Next() is true, and access the segment via Current().
+func NewIterator[T ~string | ~[]byte](data T) *Iterator[T] {
+	return &Iterator[T]{
+		data:    data,
+		options: DefaultOptions,
+	}
+}
+
+// SetOptions sets the tailorings used by the iterator, and resets it to the
+// beginning of the data.
+func (iter *Iterator[T]) SetOptions(options Options) {
+	iter.options = options
+	iter.constraints.technical = nil
+	if options.technical != 0 {
+		iter.constraints.technical = iter.technical.at
+	}
+	iter.Reset()
+}
+
+// Next advances the iterator to the next segment.
+// Returns false when there are no more segments.
+func (iter *Iterator[T]) Next() bool {
+	if iter.pos >= len(iter.data) {
+		return false
+	}
+	iter.start = iter.pos
+
+	if iter.pos < iter.end {
+		// The remainder of a segment, which was split
+		iter.split()
+		return true
+	}
+
+	var c *constraints
+	if !iter.constraints.empty() {
+		c = &iter.constraints
+		c.base = iter.pos
+		iter.technical.forget(iter.pos)
+	}
+	if ov := iter.options.overrides; ov != nil && ov.singleLetters != nil {
+		// Whether a single-letter word at iter.pos stands alone depends on
+		// the text before it
+		iter.constraints.midWord = iter.pos > 0 && !wordStartAt(iter.data, iter.pos, ov)
+		c = &iter.constraints
+		c.base = iter.pos
+	}
+	advance, kind := nextBreak(iter.data[iter.pos:], &iter.options, c)
+	if advance <= 0 {
+		panic("nextBreak returned a zero or negative advance")
+	}
+	iter.pos += advance
+	if iter.pos > len(iter.data) {
+		panic("nextBreak advanced beyond end of data")
+	}
+	iter.boundary = boundary{kind: kind}
+	switch kind {
+	case breakMandatory:
+		iter.sep = separatorOf(iter.Current(), &iter.options)
+	case breakOpportunity:
+		iter.hyphen = hyphenOf(iter.Current(), &iter.options)
+		if iter.hyphen == softHyphen {
+			iter.kind = breakHyphenated
+		}
+		iter.opportunity = iter.opportunityAt(iter.pos)
+	}
+	iter.end = iter.pos
+	iter.endBoundary = iter.boundary
+
+	if iter.options.hyphenator != nil || iter.options.complex != nil {
+		iter.findInner()
+		if len(iter.inner) > 0 {
+			iter.pos = iter.start
+			iter.split()
+		}
+	}
+	return true
+}
+
+// opportunityAt returns the kind of the opportunity at pos, the end of the
+// current segment.
+func (iter *Iterator[T]) opportunityAt(pos int) Opportunity {
+	if iter.constraints.allowedByCaller(pos) {
+		return OpportunityCaller
+	}
+	if iter.options.technical != 0 {
+		if _, allowed := iter.technical.at(pos); allowed {
+			if within, low := iter.technical.within(pos); low {
+				return OpportunityIdentifier
+			} else if within {
+				return OpportunityTechnical
+			}
+		}
+	}
+	if iter.kind == breakHyphenated {
+		return OpportunityHyphenation
+	}
+	return opportunityOf(iter.Current(), iter.data[pos:], &iter.options)
+}
+
+// findInner finds the inner breaks of the current segment.
+func (iter *Iterator[T]) findInner() {
+	segment := iter.Current()
+	iter.inner = iter.inner[:0]
+	if iter.options.complex != nil {
+		for _, pos := range complexBreaks(segment, &iter.options) {
+			iter.inner = append(iter.inner, innerBreak{pos: iter.start + pos, kind: breakOpportunity, opportunity: OpportunityWord})
+		}
+	}
+	if iter.options.hyphenator != nil {
+		for _, pos := range hyphenationPoints(segment, &iter.options) {
+			iter.inner = append(iter.inner, innerBreak{pos: iter.start + pos, kind: breakHyphenated, opportunity: OpportunityHyphenation})
+		}
+	}
+	if !iter.constraints.empty() {
+		iter.inner = slices.DeleteFunc(iter.inner, func(b innerBreak) bool {
+			return iter.constraints.holds(b.pos)
+		})
+	}
+	slices.SortFunc(iter.inner, func(a, b innerBreak) int {
+		return a.pos - b.pos
+	})
+}
+
+// split advances to the next inner break before end, or to end.
+func (iter *Iterator[T]) split() {
+	for len(iter.inner) > 0 && iter.inner[0].pos <= iter.pos {
+		iter.inner = iter.inner[1:]
+	}
+	if len(iter.inner) > 0 {
+		iter.pos = iter.inner[0].pos
+		iter.boundary = boundary{kind: iter.inner[0].kind, opportunity: iter.inner[0].opportunity}
+		return
+	}
+	iter.pos = iter.end
+	iter.boundary = iter.endBoundary
+}
+
+// Current returns the current segment, which includes any trailing spaces
+// and line terminators.
+func (iter *Iterator[T]) Current() T {
+	return iter.data[iter.start:iter.pos]
+}
+
+// Start returns the byte position of the current segment in the original data.
+func (iter *Iterator[T]) Start() int {
+	return iter.start
+}
+
+// End returns the byte position after the current segment in the original data.
+func (iter *Iterator[T]) End() int {
+	return iter.pos
+}
+
+// MustBreak returns true if the break after the current segment is
+// mandatory, such as after a line terminator or at the end of text.
+func (iter *Iterator[T]) MustBreak() bool {
+	return iter.kind == breakMandatory
+}
+
+// CanBreak returns true if the break after the current segment is an
+// opportunity, i.e. a line may be broken there but need not be.
+func (iter *Iterator[T]) CanBreak() bool {
+	return iter.kind == breakOpportunity || iter.kind == breakHyphenated
+}
+
+// Hyphenated returns true if the break after the current segment is an
+// opportunity directly after U+00AD SOFT HYPHEN, or at a hyphenation point
+// found by a [Hyphenator]. A renderer which breaks there draws a hyphen at
+// the end of the line, and otherwise hides any soft hyphen.
+func (iter *Iterator[T]) Hyphenated() bool {
+	return iter.kind == breakHyphenated
+}
+
+// AfterHyphen returns true if the break after the current segment is an
+// opportunity directly after a visible hyphen, of class HY or HH, such as
+// U+002D HYPHEN-MINUS, U+2010 HYPHEN or U+2013 EN DASH. The hyphen is drawn
+// whether or not the line is broken there; see also [Iterator.RepeatHyphen].
+func (iter *Iterator[T]) AfterHyphen() bool {
+	return iter.hyphen == visibleHyphen || iter.hyphen == repeatedHyphen
+}
+
+// RepeatHyphen returns true if the break after the current segment is an
+// opportunity directly after U+2010 HYPHEN. Some conventions, such as those
+// of Polish and Portuguese, repeat the hyphen of a compound word at the
+// start of the next line, where the line is broken there. U+002D
+// HYPHEN-MINUS, which may be a minus sign, and dashes, such as U+2013 EN
+// DASH, of the same class as U+2010, are not repeated.
+func (iter *Iterator[T]) RepeatHyphen() bool {
+	return iter.hyphen == repeatedHyphen
+}
+
+// LowPriority returns true if the break after the current segment is an
+// opportunity of low priority, to be taken only if no other opportunity
+// will do, such as within an identifier; see [TechnicalIdentifiers].
+func (iter *Iterator[T]) LowPriority() bool {
+	return iter.CanBreak() && iter.opportunity == OpportunityIdentifier
+}
+
+// Opportunity returns the kind of the opportunity after the current
+// segment, such as [OpportunitySpace] after spaces, or [OpportunityHyphen]
+// after a hyphen. It is only meaningful where CanBreak is true.
+func (iter *Iterator[T]) Opportunity() Opportunity {
+	return iter.opportunity
+}
+
+// Priority returns the priority of the opportunity after the current
+// segment, higher being better, from the default table or
+// [Options.WithPriorities]. A line fitter may prefer an earlier opportunity
+// of higher priority to a later one of lower priority. Priority returns 0
+// where CanBreak is false.
+func (iter *Iterator[T]) Priority() int {
+	if !iter.CanBreak() {
+		return 0
+	}
+	return iter.options.priority(iter.opportunity)
+}
+
+// EmergencyBreak returns true if the current segment was split by
+// [Iterator.Overflow], where there is no opportunity. Neither MustBreak nor
+// CanBreak is true for such a break.
+func (iter *Iterator[T]) EmergencyBreak() bool {
+	return iter.kind == breakEmergency
+}
+
+// Overflow reports that the current segment does not fit, such as a long URL
+// or hash in a narrow column, and splits it at an extended grapheme cluster
+// boundary, as in CSS overflow-wrap: anywhere. Because segments end at the
+// first opportunity, call Overflow only when a segment does not fit on an
+// otherwise empty line; normal opportunities are thereby preferred.
+//
+// The current segment is shortened to its longest prefix of whole clusters
+// for which fits returns true, but to at least one cluster, and ends in an
+// emergency break. Trailing spaces and line terminators are never split
+// from the segment. The remainder is returned by the next call to Next,
+// where Overflow may be called again.
+//
+// Overflow returns false, leaving the segment unchanged, if it cannot be
+// split, or if it fits after all, excluding trailing spaces and line
+// terminators.
+func (iter *Iterator[T]) Overflow(fits func(segment T) bool) bool {
+	segment := iter.Current()
+	limit := len(segment)
+	for limit > 0 {
+		r := decodeLastRune(segment[:limit])
+		if r == utf8.RuneError {
+			break
+		}
+		if p, _ := lookupProperty(segment[limit-utf8.RuneLen(r) : limit]); !p.is(_SP | _BK | _CR | _LF | _NL) {
+			break
+		}
+		limit -= utf8.RuneLen(r)
+	}
+
+	// At least one cluster, even if it does not fit
+	end := 0
+	for next := nextGrapheme(segment); ; next += nextGrapheme(segment[next:]) {
+		if next < limit && iter.constraints.holds(iter.start+next) {
+			continue
+		}
+		if end > 0 && !fits(segment[:min(next, limit)]) {
+			break
+		}
+		if next >= limit {
+			return false
+		}
+		end = next
+	}
+
+	iter.pos = iter.start + end
+	iter.boundary = boundary{kind: breakEmergency}
+	return true
+}
+
+// Separator returns the kind of line terminator which caused a mandatory
+// break after the current segment, such as [ParagraphSeparator] or
+// [PageBreak]. It returns [NoSeparator] if the break is an opportunity, or
+// if the segment ends only because the text does.
+func (iter *Iterator[T]) Separator() Separator {
+	return iter.sep
+}
+
+// Reset resets the iterator to the beginning of the data.
+func (iter *Iterator[T]) Reset() {
+	iter.start = 0
+	iter.pos = 0
+	iter.boundary = boundary{}
+	iter.end = 0
+	iter.inner = iter.inner[:0]
+	iter.technical.reset(iter.data, iter.options.technical)
+}
+
+// SetText sets the data for the iterator to operate on, and resets all state.
+func (iter *Iterator[T]) SetText(data T) {
+	iter.data = data
+	iter.constraints.noBreak = nil
+	iter.constraints.allowBreak = nil
+	iter.Reset()
+}
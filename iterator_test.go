@@ -0,0 +1,213 @@
+package uax14
+
+import (
+	"testing"
+)
+
+func TestIterator_Segments(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		want  []string
+		kinds []breakKind
+	}{
+		{
+			name:  "words and spaces",
+			in:    "Hello, world!",
+			want:  []string{"Hello, ", "world!"},
+			kinds: []breakKind{breakOpportunity, breakMandatory},
+		},
+		{
+			name:  "mandatory break after LF",
+			in:    "a b\nc",
+			want:  []string{"a ", "b\n", "c"},
+			kinds: []breakKind{breakOpportunity, breakMandatory, breakMandatory},
+		},
+		{
+			name:  "CRLF is one break",
+			in:    "a\r\nb",
+			want:  []string{"a\r\n", "b"},
+			kinds: []breakKind{breakMandatory, breakMandatory},
+		},
+		{
+			name:  "ideographs",
+			in:    "中文",
+			want:  []string{"中", "文"},
+			kinds: []breakKind{breakOpportunity, breakMandatory},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iter := NewIterator(tt.in)
+			var got []string
+			var kinds []breakKind
+			for iter.Next() {
+				got = append(got, iter.Current())
+				kinds = append(kinds, iter.kind)
+				if iter.MustBreak() == iter.CanBreak() {
+					t.Fatalf("segment %q: MustBreak and CanBreak should be exclusive", iter.Current())
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] || kinds[i] != tt.kinds[i] {
+					t.Fatalf("got %q %v, want %q %v", got, kinds, tt.want, tt.kinds)
+				}
+			}
+		})
+	}
+}
+
+func TestIterator_StringAndBytesParity(t *testing.T) {
+	in := "The quick (\"brown\") fox—can't jump 32.3 feet, right? 中文 🇺🇸🇺🇸\r\nend"
+
+	s := NewIterator(in)
+	b := NewIterator([]byte(in))
+	for s.Next() {
+		if !b.Next() {
+			t.Fatalf("bytes iterator ended early at %d", s.Start())
+		}
+		if s.Current() != string(b.Current()) || s.Start() != b.Start() || s.End() != b.End() || s.MustBreak() != b.MustBreak() {
+			t.Fatalf("parity mismatch: string=%q bytes=%q", s.Current(), b.Current())
+		}
+	}
+	if b.Next() {
+		t.Fatalf("string iterator ended early at %d", b.Start())
+	}
+}
+
+func TestIterator_Reset(t *testing.T) {
+	iter := NewIterator("a b c")
+	for iter.Next() {
+	}
+	iter.SetText("d e")
+
+	var got []string
+	for iter.Next() {
+		got = append(got, iter.Current())
+	}
+	if len(got) != 2 || got[0] != "d " || got[1] != "e" {
+		t.Fatalf("got %q after SetText", got)
+	}
+}
+
+func TestIterator_Overflow(t *testing.T) {
+	// fits allows segments of up to n bytes
+	fits := func(n int) func(string) bool {
+		return func(s string) bool { return len(s) <= n }
+	}
+
+	tests := []struct {
+		name      string
+		in        string
+		width     int
+		want      []string
+		emergency []bool
+	}{
+		{
+			name:      "hash",
+			in:        "sha 0123456789abcdef",
+			width:     6,
+			want:      []string{"sha ", "012345", "6789ab", "cdef"},
+			emergency: []bool{false, true, true, false},
+		},
+		{
+			name:      "trailing spaces stay",
+			in:        "abcdef  x",
+			width:     3,
+			want:      []string{"abc", "def  ", "x"},
+			emergency: []bool{true, false, false},
+		},
+		{
+			name:      "grapheme clusters",
+			in:        "e\u0301e\u0301e\u0301",
+			width:     4,
+			want:      []string{"e\u0301", "e\u0301", "e\u0301"},
+			emergency: []bool{true, true, false},
+		},
+		{
+			name:      "at least one cluster",
+			in:        "a\u0301\u0301b",
+			width:     1,
+			want:      []string{"a\u0301\u0301", "b"},
+			emergency: []bool{true, false},
+		},
+		{
+			name:      "invalid UTF-8",
+			in:        "\xff",
+			width:     0,
+			want:      []string{"\xff"},
+			emergency: []bool{false},
+		},
+		{
+			name:      "ends in invalid UTF-8",
+			in:        "abc\xff",
+			width:     2,
+			want:      []string{"ab", "c\xff"},
+			emergency: []bool{true, false},
+		},
+		{
+			name:      "opportunities preferred",
+			in:        "ab cd",
+			width:     3,
+			want:      []string{"ab ", "cd"},
+			emergency: []bool{false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iter := NewIterator(tt.in)
+			var got []string
+			var emergency []bool
+			for iter.Next() {
+				if len(iter.Current()) > tt.width {
+					iter.Overflow(fits(tt.width))
+				}
+				got = append(got, iter.Current())
+				emergency = append(emergency, iter.EmergencyBreak())
+				if iter.EmergencyBreak() && (iter.MustBreak() || iter.CanBreak() || iter.Separator() != NoSeparator) {
+					t.Fatalf("segment %q: emergency break should be exclusive", iter.Current())
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %q %v, want %q %v", got, emergency, tt.want, tt.emergency)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] || emergency[i] != tt.emergency[i] {
+					t.Fatalf("got %q %v, want %q %v", got, emergency, tt.want, tt.emergency)
+				}
+			}
+		})
+	}
+}
+
+func TestIterator_OverflowRemainder(t *testing.T) {
+	// The remainder keeps the kind of the original break
+	iter := NewIterator("abcdef\u2029g")
+	iter.Next()
+	if !iter.Overflow(func(s string) bool { return len(s) <= 2 }) {
+		t.Fatal("expected a split")
+	}
+	if iter.Current() != "ab" {
+		t.Fatalf("got %q, want %q", iter.Current(), "ab")
+	}
+	iter.Next()
+	if iter.Current() != "cdef\u2029" || !iter.MustBreak() || iter.Separator() != ParagraphSeparator {
+		t.Fatalf("got %q, separator %d", iter.Current(), iter.Separator())
+	}
+
+	// Nothing to split
+	iter.Next()
+	if iter.Overflow(func(string) bool { return false }) {
+		t.Fatalf("split a single cluster %q", iter.Current())
+	}
+	iter.Reset()
+	iter.Next()
+	if iter.Overflow(func(string) bool { return true }) {
+		t.Fatalf("split a segment which fits")
+	}
+}
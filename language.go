@@ -0,0 +1,105 @@
+package uax14
+
+import "strings"
+
+// languageTag is a parsed BCP 47 language tag. Subtags are lowercase, and
+// empty if absent.
+//
+// See https://www.rfc-editor.org/rfc/rfc5646.
+type languageTag struct {
+	language string
+	script   string
+	region   string
+	// keywords are the keys and types of the -u- extension, such as
+	// "lb" → "strict"
+	keywords map[string]string
+}
+
+// parseLanguageTag parses a BCP 47 language tag, such as "zh-Hant-TW" or
+// "ja-JP-u-lb-loose". Parsing is lenient: case is ignored, _ is accepted
+// as a separator, and malformed subtags are skipped.
+func parseLanguageTag(tag string) languageTag {
+	var lt languageTag
+	subtags := strings.FieldsFunc(strings.ToLower(tag), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+
+	i := 0
+	// Language, e.g. zh, or an extended language subtag, e.g. zh-yue
+	if i < len(subtags) && isAlpha(subtags[i]) && (len(subtags[i]) >= 2 && len(subtags[i]) <= 3 || len(subtags[i]) >= 5 && len(subtags[i]) <= 8) {
+		lt.language = subtags[i]
+		i++
+		for n := 0; n < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]); n++ {
+			lt.language = subtags[i]
+			i++
+		}
+	}
+
+	for ; i < len(subtags); i++ {
+		s := subtags[i]
+		switch {
+		case len(s) == 4 && isAlpha(s) && lt.script == "" && lt.region == "":
+			lt.script = s
+		case (len(s) == 2 && isAlpha(s) || len(s) == 3 && isDigit(s)) && lt.region == "":
+			lt.region = s
+		case s == "x":
+			// Private use, to the end
+			return lt
+		case s == "u":
+			i = lt.parseUnicodeExtension(subtags, i+1) - 1
+		case len(s) == 1:
+			// Other extensions, skipped to the next singleton
+			for i+1 < len(subtags) && len(subtags[i+1]) > 1 {
+				i++
+			}
+		default:
+			// Variants and malformed subtags
+		}
+	}
+	return lt
+}
+
+// parseUnicodeExtension parses the attributes and keywords of a -u-
+// extension, starting at subtags[i], and returns the index of the subtag
+// after it.
+func (lt *languageTag) parseUnicodeExtension(subtags []string, i int) int {
+	// Attributes, which precede keywords, are ignored
+	for i < len(subtags) && len(subtags[i]) >= 3 {
+		i++
+	}
+
+	for i < len(subtags) && len(subtags[i]) == 2 {
+		key := subtags[i]
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 3 {
+			i++
+		}
+		if lt.keywords == nil {
+			lt.keywords = map[string]string{}
+		}
+		if _, ok := lt.keywords[key]; !ok {
+			// The first occurrence of a key wins
+			lt.keywords[key] = strings.Join(subtags[start:i], "-")
+		}
+	}
+	return i
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
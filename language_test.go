@@ -0,0 +1,50 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseLanguageTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want languageTag
+	}{
+		{"", languageTag{}},
+		{"ja", languageTag{language: "ja"}},
+		{"ja-JP", languageTag{language: "ja", region: "jp"}},
+		{"zh-Hant-TW", languageTag{language: "zh", script: "hant", region: "tw"}},
+		{"zh_hans_cn", languageTag{language: "zh", script: "hans", region: "cn"}},
+		{"zh-yue-HK", languageTag{language: "yue", region: "hk"}},
+		{"es-419", languageTag{language: "es", region: "419"}},
+		{"de-CH-1901", languageTag{language: "de", region: "ch"}},
+		{"sl-rozaj-biske", languageTag{language: "sl"}},
+		{"und-Hani", languageTag{language: "und", script: "hani"}},
+		{
+			"ja-JP-u-lb-loose-lw-keepall",
+			languageTag{language: "ja", region: "jp", keywords: map[string]string{"lb": "loose", "lw": "keepall"}},
+		},
+		{
+			"ja-u-attr-lb-strict-lb-loose",
+			languageTag{language: "ja", keywords: map[string]string{"lb": "strict"}},
+		},
+		{
+			"en-a-bbb-u-ca-islamic-civil-x-lw-keepall",
+			languageTag{language: "en", keywords: map[string]string{"ca": "islamic-civil"}},
+		},
+		{
+			"ko-t-ja-u-lw-keepall",
+			languageTag{language: "ko", keywords: map[string]string{"lw": "keepall"}},
+		},
+		{"x-private", languageTag{}},
+		{"i-klingon", languageTag{}},
+		{"1234-JP", languageTag{region: "jp"}},
+	}
+
+	for _, tt := range tests {
+		got := parseLanguageTag(tt.tag)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("parseLanguageTag(%q) = %+v, want %+v", tt.tag, got, tt.want)
+		}
+	}
+}
@@ -28,3 +28,33 @@ func isValidUTF8Prefix[T ~string | ~[]byte](data T, sz int) bool {
 		return false
 	}
 }
+
+// decodeRune returns the first rune in data, or utf8.RuneError if data does
+// not begin with valid UTF-8.
+func decodeRune[T ~string | ~[]byte](data T) rune {
+	var buf [utf8.UTFMax]byte
+	n := copy(buf[:], data)
+	r, _ := utf8.DecodeRune(buf[:n])
+	return r
+}
+
+// decodeRuneWidth is decodeRune, which also returns the width of the rune in
+// bytes, 1 where data does not begin with valid UTF-8, and 0 where it is
+// empty.
+func decodeRuneWidth[T ~string | ~[]byte](data T) (rune, int) {
+	var buf [utf8.UTFMax]byte
+	n := copy(buf[:], data)
+	return utf8.DecodeRune(buf[:n])
+}
+
+// decodeLastRune returns the last rune in data, or utf8.RuneError if data
+// does not end with valid UTF-8.
+func decodeLastRune[T ~string | ~[]byte](data T) rune {
+	if len(data) > utf8.UTFMax {
+		data = data[len(data)-utf8.UTFMax:]
+	}
+	var buf [utf8.UTFMax]byte
+	n := copy(buf[:], data)
+	r, _ := utf8.DecodeLastRune(buf[:n])
+	return r
+}
@@ -63,7 +63,7 @@ func TestLookup_EastAsianWidthBit(t *testing.T) {
 		t.Fatalf("lookupProperty(%q) should include _EA", "中")
 	}
 	got, _ = lookupProperty("A")
-	if !got.is(_EA) {
+	if got.is(_EA) {
 		t.Fatalf("lookupProperty(%q) should not include _EA", "A")
 	}
 }
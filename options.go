@@ -0,0 +1,51 @@
+package uax14
+
+// Options specifies tailorings of the default line breaking algorithm.
+// The zero value applies the default UAX #14 rules.
+type Options struct {
+	// rules are custom rules, by position in the default rule chain
+	rules [numPositions][]Rule
+	// ruleset replaces the built-in rules, if not nil
+	ruleset *Ruleset
+	// overrides reassign classes of individual runes, if not nil
+	overrides *overrides
+	// graphemes suppresses breaks within grapheme clusters
+	graphemes bool
+	// separatorsAsNewlines reports LS and PS as Newline
+	separatorsAsNewlines bool
+	// hyphenator adds hyphenation points within words, if not nil
+	hyphenator *Hyphenator
+	// complex finds word boundaries in runs of SA, if not nil
+	complex ComplexContextSegmenter
+	// technical selects tailorings for technical text
+	technical Technical
+	// priorities override the default priorities of opportunities, if not
+	// nil
+	priorities *[numOpportunities]int
+}
+
+// DefaultOptions applies the default UAX #14 rules, with no tailoring.
+var DefaultOptions = Options{}
+
+// WithRule returns a copy of options, with rule evaluated ahead of the
+// default rules at the given position. Rules at the same position are
+// evaluated in the order they were added; the first to return a decision
+// other than [Continue] wins.
+func (options Options) WithRule(position Position, rule Rule) Options {
+	rules := options.rules[position]
+	// Full slice expression forces a copy, so options derived from the
+	// same parent do not share a backing array.
+	options.rules[position] = append(rules[:len(rules):len(rules)], rule)
+	return options
+}
+
+// WithGraphemeBoundaries returns a copy of options which, if enabled, only
+// breaks on extended grapheme cluster boundaries, per UAX #29. Break
+// opportunities within a cluster, such as some Indic conjuncts and emoji
+// sequences, are suppressed.
+//
+// See https://unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries.
+func (options Options) WithGraphemeBoundaries(enabled bool) Options {
+	options.graphemes = enabled
+	return options
+}
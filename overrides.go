@@ -0,0 +1,306 @@
+package uax14
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"unicode/utf8"
+)
+
+// WithClassOverrides returns a copy of options, with the line breaking
+// classes of the given runes reassigned, for example '—' to B2, or '_' to
+// BA. Overrides combine with those of earlier calls, later ones winning.
+//
+// Overrides are compiled into a sorted range table, which is consulted only
+// for characters whose UTF-8 lead byte it covers; other characters are
+// looked up at full speed. The compiled table is immutable, and options
+// which share it are safe to use across goroutines.
+//
+// An overridden rune keeps its East Asian width and Extended_Pictographic
+// properties, and its Pi/Pf general category if it remains QU.
+//
+// WithClassOverrides panics if a rune is not a valid Unicode scalar value,
+// or if a Class is not exactly one line breaking class.
+func (options Options) WithClassOverrides(overrides map[rune]Class) Options {
+	for r, c := range overrides {
+		if !utf8.ValidRune(r) {
+			panic(fmt.Sprintf("uax14: invalid rune %U in class overrides", r))
+		}
+		mustBeClass(c, fmt.Sprintf("class override for %U", r))
+	}
+
+	return options.tailor(func(t *tailoring) {
+		classes := make(map[rune]Class, len(t.classes)+len(overrides))
+		for r, c := range t.classes {
+			classes[r] = c
+		}
+		for r, c := range overrides {
+			classes[r] = c
+		}
+		t.classes = classes
+	})
+}
+
+// mustBeClass panics if c is not exactly one line breaking class.
+func mustBeClass(c Class, what string) {
+	if bits.OnesCount64(uint64(c)) != 1 || property(c)&annotations != 0 {
+		panic(fmt.Sprintf("uax14: %s is not a single class: %#x", what, uint64(c)))
+	}
+}
+
+// tailoring is the source of an overrides table. It is a value; maps are
+// replaced rather than modified, so copies may share them.
+type tailoring struct {
+	// classes are overrides by rune, see [Options.WithClassOverrides]
+	classes map[rune]Class
+	// quotes are the _PI and _PF bits of quotation marks, see [Options.WithQuotes]
+	quotes map[rune]property
+	// strictness, see [Options.WithStrictness]
+	strictness Strictness
+	// wordBreak, see [Options.WithWordBreak]
+	wordBreak WordBreak
+	// ideographs, see [Options.WithIdeographs]
+	ideographs Ideographs
+	// ambiguous is the class of AI characters, or 0 for AL
+	ambiguous property
+	// singleLetters, in both cases, see [Options.WithSingleLetterWords]
+	singleLetters map[rune]bool
+	// frenchSpacing, see [Options.WithFrenchSpacing]
+	frenchSpacing bool
+}
+
+// tailor returns a copy of options, with its tailoring modified by f and
+// recompiled.
+func (options Options) tailor(f func(t *tailoring)) Options {
+	var t tailoring
+	if options.overrides != nil {
+		t = options.overrides.tailoring
+	}
+	f(&t)
+	options.overrides = compileOverrides(t)
+	return options
+}
+
+// overrides is a compiled table of per-rune properties, consulted ahead of
+// the trie, and of class resolutions, applied after it.
+type overrides struct {
+	// leads is a bitmap of the UTF-8 lead bytes of overridden runes
+	leads [4]uint64
+	// ranges are sorted and non-overlapping
+	ranges []overrideRange
+	// resolves reassign classes after lookup, in order
+	resolves []classResolve
+	// keepAll suppresses breaks between letters, see [WordBreakKeepAll]
+	keepAll bool
+	// korean suppresses breaks within Korean words, see [WordBreakKorean]
+	korean bool
+	// ideographs are kept with Hangul, or within words, if korean
+	ideographs Ideographs
+	// singleLetters are words kept from the end of a line, if not nil
+	singleLetters map[rune]bool
+	// frenchSpacing keeps spaces with French punctuation
+	frenchSpacing bool
+	// tailoring is the source of the table, for combining with later ones
+	tailoring tailoring
+}
+
+// overrideRange assigns prop to the runes lo through hi, inclusive.
+type overrideRange struct {
+	lo, hi rune
+	prop   property
+}
+
+// classResolve assigns class to characters with any of the bits in from.
+type classResolve struct {
+	from  property
+	class property
+}
+
+// compileOverrides compiles t, returning nil if it has no effect.
+func compileOverrides(t tailoring) *overrides {
+	ov := &overrides{tailoring: t}
+
+	// Per-rune tailorings, in increasing order of precedence
+	var strictness map[rune]property
+	switch t.strictness {
+	case StrictnessNormal:
+		strictness = normalRunes
+	case StrictnessLoose:
+		strictness = looseRunes
+	}
+
+	runes := make([]rune, 0, len(strictness)+len(t.classes)+len(t.quotes))
+	seen := make(map[rune]bool, cap(runes))
+	for _, m := range []map[rune]property{strictness, t.quotes} {
+		for r := range m {
+			if !seen[r] {
+				seen[r] = true
+				runes = append(runes, r)
+			}
+		}
+	}
+	for r := range t.classes {
+		if !seen[r] {
+			seen[r] = true
+			runes = append(runes, r)
+		}
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	var buf [utf8.UTFMax]byte
+	for _, r := range runes {
+		n := utf8.EncodeRune(buf[:], r)
+		orig, _ := lookupProperty(buf[:n])
+		prop := orig
+		if c, ok := strictness[r]; ok {
+			prop = c | orig&(_EA|_EPU)
+		}
+		if c, ok := t.classes[r]; ok {
+			// Keep properties which are independent of the class
+			prop = property(c) | orig&(_EA|_EPU)
+			if prop.is(_QU) {
+				prop |= orig & (_PI | _PF)
+			}
+		}
+		if q, ok := t.quotes[r]; ok && prop.is(_QU) {
+			prop = prop&^(_PI|_PF) | q
+		}
+		if prop == orig {
+			continue
+		}
+
+		lead := buf[0]
+		ov.leads[lead>>6] |= 1 << (lead & 63)
+
+		if last := len(ov.ranges) - 1; last >= 0 && ov.ranges[last].hi == r-1 && ov.ranges[last].prop == prop {
+			ov.ranges[last].hi = r
+			continue
+		}
+		ov.ranges = append(ov.ranges, overrideRange{lo: r, hi: r, prop: prop})
+	}
+
+	// Class resolutions, in order
+	if t.ambiguous != 0 && t.ambiguous != _AL {
+		ov.resolves = append(ov.resolves, classResolve{from: _AI, class: t.ambiguous})
+	}
+	if t.strictness != StrictnessStrict {
+		ov.resolves = append(ov.resolves, classResolve{from: _CJ, class: _ID})
+	}
+	switch t.wordBreak {
+	case WordBreakBreakAll:
+		ov.resolves = append(ov.resolves, classResolve{from: _AL | _HL | _NU, class: _ID})
+	case WordBreakKeepAll:
+		ov.keepAll = true
+	case WordBreakKorean:
+		ov.korean = true
+		ov.ideographs = t.ideographs
+	}
+
+	ov.singleLetters = t.singleLetters
+	ov.frenchSpacing = t.frenchSpacing
+
+	if len(ov.ranges) == 0 && len(ov.resolves) == 0 && !ov.keepAll && !ov.korean && ov.singleLetters == nil && !ov.frenchSpacing {
+		return nil
+	}
+	return ov
+}
+
+// keeps reports whether a break between left and right, the classes of the
+// characters around it, is suppressed by word breaking; see
+// [WordBreakKeepAll] and [WordBreakKorean].
+func (ov *overrides) keeps(left, right property) bool {
+	switch {
+	case ov == nil:
+		return false
+	case ov.keepAll:
+		return left.is(keepAllClasses) && right.is(keepAllClasses)
+	case !ov.korean:
+		return false
+	}
+
+	if left.is(koreanWordClasses) && right.is(koreanWordClasses) && (left.is(hangul) || right.is(hangul)) {
+		return true
+	}
+	switch ov.ideographs {
+	case IdeographsAttach:
+		return left.is(hangul) && right.is(_ID) || left.is(_ID) && right.is(hangul)
+	case IdeographsKeep:
+		return left.is(koreanWordClasses|_ID) && right.is(koreanWordClasses|_ID)
+	}
+	return false
+}
+
+// overrideOf returns the overridden property of the character of width w at the
+// start of data, if any.
+func overrideOf[T ~string | ~[]byte](ov *overrides, data T, w int) (property, bool) {
+	if ov == nil || w == 0 {
+		return 0, false
+	}
+	lead := data[0]
+	if ov.leads[lead>>6]&(1<<(lead&63)) == 0 {
+		return 0, false
+	}
+
+	var buf [utf8.UTFMax]byte
+	n := copy(buf[:], data[:w])
+	r, size := utf8.DecodeRune(buf[:n])
+	if size != w || (r == utf8.RuneError && size == 1) {
+		// Invalid UTF-8 is never overridden, even if U+FFFD is
+		return 0, false
+	}
+
+	// Binary search, for the first range ending at or after r
+	lo, hi := 0, len(ov.ranges)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if ov.ranges[mid].hi < r {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(ov.ranges) && ov.ranges[lo].lo <= r {
+		return ov.ranges[lo].prop, true
+	}
+	return 0, false
+}
+
+// resolve applies the class resolutions of ov to p.
+func (ov *overrides) resolve(p property) property {
+	for _, r := range ov.resolves {
+		if p.is(r.from) {
+			p = p&annotations | r.class
+		}
+	}
+	return p
+}
+
+// lookupWith is lookup, with overrides applied.
+func lookupWith[T ~string | ~[]byte](data T, ov *overrides) (property, int) {
+	p, w := lookup(data)
+	if ov == nil {
+		return p, w
+	}
+	if q, ok := overrideOf(ov, data, w); ok {
+		p = q
+	}
+	if p != 0 {
+		p = ov.resolve(p)
+	}
+	return p, w
+}
+
+// lookupPropertyWith is lookupProperty, with overrides applied.
+func lookupPropertyWith[T ~string | ~[]byte](data T, ov *overrides) (property, int) {
+	p, w := lookupProperty(data)
+	if ov == nil {
+		return p, w
+	}
+	if q, ok := overrideOf(ov, data, w); ok {
+		p = q
+	}
+	if p != 0 {
+		p = ov.resolve(p)
+	}
+	return p, w
+}
@@ -0,0 +1,203 @@
+package uax14
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var defaultRuleset = MustParseRuleset(DefaultRules)
+
+func TestClassOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[rune]Class
+		input     string
+		want      []string
+	}{
+		{
+			name:  "default",
+			input: "foo_bar a+b",
+			want:  []string{"foo_bar ", "a+b"},
+		},
+		{
+			name:      "underscore as BA",
+			overrides: map[rune]Class{'_': BA},
+			input:     "foo_bar a+b",
+			want:      []string{"foo_", "bar ", "a+b"},
+		},
+		{
+			name:      "several runes",
+			overrides: map[rune]Class{'_': BA, '+': BA},
+			input:     "foo_bar a+b",
+			want:      []string{"foo_", "bar ", "a+", "b"},
+		},
+		{
+			name:      "multi-byte",
+			overrides: map[rune]Class{'中': AL, '文': AL},
+			input:     "中文 ab中文",
+			want:      []string{"中文 ", "ab中文"},
+		},
+		{
+			name:      "supplementary plane",
+			overrides: map[rune]Class{'😀': AL},
+			input:     "a😀b",
+			want:      []string{"a😀b"},
+		},
+		{
+			name:      "same lead byte, not overridden",
+			overrides: map[rune]Class{'中': AL},
+			input:     "中文",
+			want:      []string{"中", "文"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DefaultOptions
+			if tt.overrides != nil {
+				options = options.WithClassOverrides(tt.overrides)
+			}
+			got := segments(tt.input, options)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			gotB := segments([]byte(tt.input), options)
+			if fmt.Sprintf("%s", gotB) != fmt.Sprint(tt.want) {
+				t.Fatalf("bytes: got %q, want %q", gotB, tt.want)
+			}
+			gotR := segments(tt.input, options.WithRuleset(defaultRuleset))
+			if fmt.Sprint(gotR) != fmt.Sprint(tt.want) {
+				t.Fatalf("ruleset: got %q, want %q", gotR, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassOverrides_Combine(t *testing.T) {
+	parent := DefaultOptions.WithClassOverrides(map[rune]Class{'_': BA, '+': BA})
+	child := parent.WithClassOverrides(map[rune]Class{'+': AL})
+
+	want := []string{"foo_", "bar ", "a+", "b"}
+	if got := segments("foo_bar a+b", parent); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("parent: got %q, want %q", got, want)
+	}
+	want = []string{"foo_", "bar ", "a+b"}
+	if got := segments("foo_bar a+b", child); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("child: got %q, want %q", got, want)
+	}
+}
+
+func TestClassOverrides_KeepsProperties(t *testing.T) {
+	options := DefaultOptions.WithClassOverrides(map[rune]Class{'中': OP, '«': QU, '»': CL})
+	ov := options.overrides
+
+	got, _ := lookupPropertyWith("中", ov)
+	if !got.is(_OP) || !got.is(_EA) || got.is(_ID) {
+		t.Errorf("中: got %#x, want OP with East Asian width", got)
+	}
+	got, _ = lookupPropertyWith("«", ov)
+	if !got.is(_QU) || !got.is(_PI) {
+		t.Errorf("«: got %#x, want QU with Pi", got)
+	}
+	got, _ = lookupPropertyWith("»", ov)
+	if !got.is(_CL) || got.is(_PF|_QU) {
+		t.Errorf("»: got %#x, want CL without Pf", got)
+	}
+}
+
+func TestClassOverrides_InvalidUTF8(t *testing.T) {
+	options := DefaultOptions.WithClassOverrides(map[rune]Class{'�': BA})
+
+	got, w := lookupWith([]byte{0xEF, 0xBF, 0xBD}, options.overrides)
+	if w != 3 || !got.is(_BA) {
+		t.Errorf("U+FFFD: got %#x, %d, want BA", got, w)
+	}
+	got, w = lookupWith([]byte{0xFF}, options.overrides)
+	if got.is(_BA) {
+		t.Errorf("invalid byte: got %#x, %d, should not be overridden", got, w)
+	}
+}
+
+func TestClassOverrides_Panics(t *testing.T) {
+	tests := map[string]map[rune]Class{
+		"surrogate":   {0xD800: AL},
+		"negative":    {-1: AL},
+		"no class":    {'a': 0},
+		"two classes": {'a': AL | BA},
+	}
+	for name, overrides := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("should panic")
+				}
+			}()
+			DefaultOptions.WithClassOverrides(overrides)
+		})
+	}
+}
+
+func TestClassOverrides_Concurrent(t *testing.T) {
+	options := DefaultOptions.WithClassOverrides(map[rune]Class{'_': BA})
+	want := fmt.Sprint([]string{"foo_", "bar"})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if got := fmt.Sprint(segments("foo_bar", options)); got != want {
+					t.Errorf("got %s, want %s", got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClassOverrides_NoAllocs(t *testing.T) {
+	options := DefaultOptions.WithClassOverrides(map[rune]Class{'_': BA, '中': AL})
+	input := "foo_bar 中文 baz"
+	iter := NewIterator(input)
+	iter.SetOptions(options)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		iter.Reset()
+		for iter.Next() {
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("got %v allocs, want 0", allocs)
+	}
+}
+
+// benchmarkText is a mix of scripts and punctuation, for throughput.
+var benchmarkText = strings.Repeat("The quick (“brown”) fox—jumps over 12,345.67 lazy dogs; "+
+	"日本語のテキスト、改行。 Ünïcödé text, with soft\u00ADhyphens and http://example.com/path. ", 64)
+
+// BenchmarkNextBreak compares the default path, which should cost nothing
+// for overrides, with overrides that do not apply to the text.
+func BenchmarkNextBreak(b *testing.B) {
+	for _, bm := range []struct {
+		name    string
+		options Options
+	}{
+		{"default", DefaultOptions},
+		{"overrides", DefaultOptions.WithClassOverrides(map[rune]Class{'⸺': BA})},
+		{"ruleset", DefaultOptions.WithRuleset(MustParseRuleset(DefaultRules))},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.SetBytes(int64(len(benchmarkText)))
+			for range b.N {
+				for data := benchmarkText; len(data) > 0; {
+					n, _ := nextBreak(data, &bm.options, nil)
+					data = data[n:]
+				}
+			}
+		})
+	}
+}
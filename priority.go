@@ -0,0 +1,164 @@
+package uax14
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Opportunity is the kind of a break opportunity, by the rule which allowed
+// it and the classes around it. Each has a priority, for line fitting which
+// prefers some opportunities to others; see [Iterator.Priority].
+type Opportunity uint8
+
+const (
+	// OpportunityOther is any opportunity not listed below, such as
+	// between letters and numbers in some tailorings.
+	OpportunityOther Opportunity = iota
+	// OpportunitySpace is after spaces (LB18).
+	OpportunitySpace
+	// OpportunityZeroWidthSpace is after U+200B ZERO WIDTH SPACE, and any
+	// spaces (LB8).
+	OpportunityZeroWidthSpace
+	// OpportunityHyphen is after a visible hyphen or dash, of class HY, HH
+	// or B2, or of class HH but reassigned to BA by an override (LB21
+	// context). Other characters of class BA are punctuation.
+	OpportunityHyphen
+	// OpportunityHyphenation is after a soft hyphen, or at a hyphenation
+	// point; see [Iterator.Hyphenated].
+	OpportunityHyphenation
+	// OpportunitySlash is after a slash, of class SY.
+	OpportunitySlash
+	// OpportunityPunctuation is after other punctuation, such as closing
+	// brackets, or before opening ones.
+	OpportunityPunctuation
+	// OpportunityIdeographic is before or after an ideograph, kana or
+	// Hangul (LB31 context).
+	OpportunityIdeographic
+	// OpportunityWord is between words of a complex context script; see
+	// [ComplexContextSegmenter].
+	OpportunityWord
+	// OpportunityTechnical is within a URL, email address or path; see
+	// [Options.WithTechnical].
+	OpportunityTechnical
+	// OpportunityIdentifier is within an identifier; see
+	// [TechnicalIdentifiers].
+	OpportunityIdentifier
+	// OpportunityCaller is allowed by the caller; see
+	// [Iterator.SetConstraints].
+	OpportunityCaller
+
+	numOpportunities
+)
+
+// defaultPriorities are the priorities of opportunities, higher being
+// better.
+var defaultPriorities = [numOpportunities]int{
+	OpportunityOther:          50,
+	OpportunitySpace:          100,
+	OpportunityZeroWidthSpace: 90,
+	OpportunityHyphen:         70,
+	OpportunityHyphenation:    40,
+	OpportunitySlash:          60,
+	OpportunityPunctuation:    60,
+	OpportunityIdeographic:    80,
+	OpportunityWord:           90,
+	OpportunityTechnical:      50,
+	OpportunityIdentifier:     20,
+	OpportunityCaller:         80,
+}
+
+// DefaultPriority returns the default priority of an opportunity, higher
+// being better. Breaks after spaces are best, at 100; then, in order, those
+// between words of complex context scripts and after zero width spaces;
+// before or after ideographs, and allowed by the caller; after hyphens;
+// after slashes and other punctuation; within technical text, and others;
+// at hyphenation points; and within identifiers, at 20.
+func DefaultPriority(o Opportunity) int {
+	if o >= numOpportunities {
+		return 0
+	}
+	return defaultPriorities[o]
+}
+
+// WithPriorities returns a copy of options, with the priorities of the
+// given opportunities overridden. Others keep their priority, see
+// [DefaultPriority]. Overrides combine with those of earlier calls, later
+// ones winning.
+//
+// WithPriorities panics if an opportunity is not one of those above.
+func (options Options) WithPriorities(priorities map[Opportunity]int) Options {
+	table := defaultPriorities
+	if options.priorities != nil {
+		table = *options.priorities
+	}
+	for o, p := range priorities {
+		if o >= numOpportunities {
+			panic(fmt.Sprintf("uax14: invalid opportunity %d in priorities", o))
+		}
+		table[o] = p
+	}
+	options.priorities = &table
+	return options
+}
+
+// priority returns the priority of o, per options.
+func (options *Options) priority(o Opportunity) int {
+	if options.priorities != nil {
+		return options.priorities[o]
+	}
+	return defaultPriorities[o]
+}
+
+// ideographic are the classes of ideographs, kana and Hangul, and emoji
+const ideographic = _ID | _CJ | _H2 | _H3 | _JL | _JV | _JT | _EB | _EM
+
+// opportunityOf returns the kind of the opportunity at the end of segment,
+// before rest, by the classes around it.
+func opportunityOf[T ~string | ~[]byte](segment, rest T, options *Options) Opportunity {
+	// The last character before the break, less trailing spaces and marks
+	end := len(segment)
+	spaces := false
+	var last property
+	var r rune
+scan:
+	for end > 0 {
+		r = decodeLastRune(segment[:end])
+		if r == utf8.RuneError {
+			last = 0
+			break
+		}
+		w := utf8.RuneLen(r)
+		last, _ = lookupPropertyWith(segment[end-w:end], options.overrides)
+		switch {
+		case last.is(_SP) || last.is(_BA) && unicode.IsSpace(r):
+			spaces = true
+		case last.is(_CM | _ZWJ):
+		default:
+			break scan
+		}
+		end -= w
+	}
+	var next property
+	if len(rest) > 0 {
+		next, _ = lookupPropertyWith(rest, options.overrides)
+	}
+
+	switch {
+	case last.is(_ZW):
+		return OpportunityZeroWidthSpace
+	case spaces:
+		return OpportunitySpace
+	case r == '\u00AD':
+		return OpportunityHyphenation
+	case last.is(_HY|_HH|_B2) || reassignedHyphen(r, last):
+		return OpportunityHyphen
+	case last.is(_SY):
+		return OpportunitySlash
+	case last.is(ideographic) || next.is(ideographic):
+		return OpportunityIdeographic
+	case last.is(_CL|_CP|_EX|_IS|_NS|_QU|_IN|_PO|_PR|_BA) || next.is(_OP|_QU):
+		return OpportunityPunctuation
+	}
+	return OpportunityOther
+}
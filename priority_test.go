@@ -0,0 +1,182 @@
+package uax14
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// opportunities returns the segments of in, and the kinds of the
+// opportunities after all but the last.
+func opportunities(in string, options Options) ([]string, []Opportunity) {
+	iter := NewIterator(in)
+	iter.SetOptions(options)
+	var segments []string
+	var kinds []Opportunity
+	for iter.Next() {
+		segments = append(segments, iter.Current())
+		if iter.CanBreak() {
+			kinds = append(kinds, iter.Opportunity())
+		}
+	}
+	return segments, kinds
+}
+
+func TestIterator_Opportunity(t *testing.T) {
+	d, err := NewDictionarySegmenter(strings.NewReader(thaiWords))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		options Options
+		want    []string
+		kinds   []Opportunity
+	}{
+		{
+			name:  "spaces",
+			in:    "the quick\u00A0brown  fox",
+			want:  []string{"the ", "quick\u00A0brown  ", "fox"},
+			kinds: []Opportunity{OpportunitySpace, OpportunitySpace},
+		},
+		{
+			name:  "zero width space",
+			in:    "a\u200B b",
+			want:  []string{"a\u200B ", "b"},
+			kinds: []Opportunity{OpportunityZeroWidthSpace},
+		},
+		{
+			name:  "hyphens",
+			in:    "well-known\u00ADness",
+			want:  []string{"well-", "known\u00AD", "ness"},
+			kinds: []Opportunity{OpportunityHyphen, OpportunityHyphenation},
+		},
+		{
+			name:  "slash",
+			in:    "and/or",
+			want:  []string{"and/", "or"},
+			kinds: []Opportunity{OpportunitySlash},
+		},
+		{
+			name:  "punctuation",
+			in:    "a)(b",
+			want:  []string{"a)", "(b"},
+			kinds: []Opportunity{OpportunityPunctuation},
+		},
+		{
+			name:  "ideographic",
+			in:    "日本語abc",
+			want:  []string{"日", "本", "語", "abc"},
+			kinds: []Opportunity{OpportunityIdeographic, OpportunityIdeographic, OpportunityIdeographic},
+		},
+		{
+			name:    "hyphenation",
+			in:      "hyphenation",
+			options: DefaultOptions.WithHyphenator(loadHyphenator(t)),
+			want:    []string{"hy", "phen", "ation"},
+			kinds:   []Opportunity{OpportunityHyphenation, OpportunityHyphenation},
+		},
+		{
+			name:    "words",
+			in:      "ฉันกินข้าว อร่อย",
+			options: DefaultOptions.WithComplexContextSegmenter(d),
+			want:    []string{"ฉัน", "กิน", "ข้าว ", "อร่อย"},
+			kinds:   []Opportunity{OpportunityWord, OpportunityWord, OpportunitySpace},
+		},
+		{
+			name:    "technical",
+			in:      "see http://example.com/path",
+			options: DefaultOptions.WithTechnical(TechnicalURLs),
+			want:    []string{"see ", "http://", "example", ".com", "/path"},
+			kinds:   []Opportunity{OpportunitySpace, OpportunityTechnical, OpportunityTechnical, OpportunityTechnical},
+		},
+		{
+			name:    "identifiers",
+			in:      "call getValue",
+			options: DefaultOptions.WithTechnical(TechnicalIdentifiers),
+			want:    []string{"call ", "get", "Value"},
+			kinds:   []Opportunity{OpportunitySpace, OpportunityIdentifier},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{tt.options, tt.options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				got, kinds := opportunities(tt.in, options)
+				if strings.Join(got, "|") != strings.Join(tt.want, "|") || fmt.Sprint(kinds) != fmt.Sprint(tt.kinds) {
+					t.Fatalf("got %q %v, want %q %v", got, kinds, tt.want, tt.kinds)
+				}
+			})
+		}
+	}
+}
+
+func TestIterator_OpportunityCaller(t *testing.T) {
+	iter := NewIterator("abcdef ghi")
+	if err := iter.SetConstraints(nil, []int{3}); err != nil {
+		t.Fatal(err)
+	}
+	var kinds []Opportunity
+	for iter.Next() {
+		if iter.CanBreak() {
+			kinds = append(kinds, iter.Opportunity())
+		}
+	}
+	want := []Opportunity{OpportunityCaller, OpportunitySpace}
+	if fmt.Sprint(kinds) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", kinds, want)
+	}
+}
+
+func TestIterator_Priority(t *testing.T) {
+	in := "well-known ideas"
+	tests := []struct {
+		name    string
+		options Options
+		want    []int
+	}{
+		{
+			name:    "default",
+			options: DefaultOptions,
+			want:    []int{70, 100, 0},
+		},
+		{
+			name:    "overridden",
+			options: DefaultOptions.WithPriorities(map[Opportunity]int{OpportunityHyphen: 10}),
+			want:    []int{10, 100, 0},
+		},
+		{
+			name: "combined",
+			options: DefaultOptions.
+				WithPriorities(map[Opportunity]int{OpportunityHyphen: 10}).
+				WithPriorities(map[Opportunity]int{OpportunitySpace: 5}),
+			want: []int{10, 5, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iter := NewIterator(in)
+			iter.SetOptions(tt.options)
+			var got []int
+			for iter.Next() {
+				got = append(got, iter.Priority())
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Earlier options are unchanged
+	options := DefaultOptions.WithPriorities(map[Opportunity]int{OpportunityHyphen: 10})
+	_ = options.WithPriorities(map[Opportunity]int{OpportunityHyphen: 20})
+	if got := options.priority(OpportunityHyphen); got != 10 {
+		t.Fatalf("got %d, want 10", got)
+	}
+	if got := DefaultPriority(OpportunityHyphen); got != 70 {
+		t.Fatalf("got %d, want 70", got)
+	}
+}
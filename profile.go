@@ -0,0 +1,137 @@
+package uax14
+
+// ProfileForLanguage returns options tailored to the language of a BCP 47
+// tag, such as "ja-JP", "zh-Hant-TW" or "de-CH". Unknown languages, and tags
+// which do not parse, fall back to [DefaultOptions].
+//
+// Chinese, Japanese and Korean (ja, ko, zh, yue, and other Chinese
+// languages, or any tag with a Han, Japanese, Korean or Bopomofo script,
+// such as und-Hani) use:
+//
+//   - [StrictnessNormal], allowing small kana to begin a line
+//   - ambiguous characters (AI) as ID
+//
+// Latin or other scripts, as in ja-Latn, use the defaults. Where the
+// language is und or absent, a region of CN, HK, JP, KP, KR, MO, SG or TW
+// implies the above.
+//
+// Korean (ko, or any tag with Kore or Hang script, or und-KR) also breaks at
+// word units, with Hanja kept with the Hangul around them; see
+// [WordBreakKorean] and [IdeographsAttach].
+//
+// Myanmar and Khmer languages (my, km, and others, or any tag with Mymr or
+// Khmr script) break at orthographic syllables; see [SyllableSegmenter].
+//
+// Languages with a known quotation convention, such as de, da, fi, fr or
+// sv, use it; see [QuotesForLanguage].
+//
+// Czech, Slovak, Polish and Slovenian (cs, sk, pl, sl) keep single-letter
+// words such as v and z from the end of a line; see
+// [SingleLetterWordsForLanguage].
+//
+// French (fr) keeps spaces with the punctuation and guillemets around them;
+// see [Options.WithFrenchSpacing].
+//
+// The -u-lb- and -u-lw- extension keys override the above:
+//
+//   - lb: strict, normal or loose, see [Strictness]
+//   - lw: normal, breakall or keepall, see [WordBreak]; phrase, which
+//     needs phrase analysis, falls back to keepall
+func ProfileForLanguage(tag string) Options {
+	lt := parseLanguageTag(tag)
+	options := DefaultOptions
+
+	if lt.isEastAsian() {
+		options = options.WithStrictness(StrictnessNormal).WithAmbiguous(ID)
+	}
+	if lt.isKorean() {
+		options = options.WithWordBreak(WordBreakKorean).WithIdeographs(IdeographsAttach)
+	}
+	if lt.isSyllabic() {
+		options = options.WithComplexContextSegmenter(SyllableSegmenter{})
+	}
+	if quotes, ok := quotesForTag(lt); ok {
+		options = options.WithQuotes(quotes)
+	}
+	if letters, ok := singleLetterWordsForTag(lt); ok {
+		options = options.WithSingleLetterWords(letters)
+	}
+	if lt.language == "fr" {
+		options = options.WithFrenchSpacing(true)
+	}
+
+	switch lt.keywords["lb"] {
+	case "strict":
+		options = options.WithStrictness(StrictnessStrict)
+	case "normal":
+		options = options.WithStrictness(StrictnessNormal)
+	case "loose":
+		options = options.WithStrictness(StrictnessLoose)
+	}
+	switch lt.keywords["lw"] {
+	case "normal":
+		options = options.WithWordBreak(WordBreakNormal)
+	case "breakall":
+		options = options.WithWordBreak(WordBreakBreakAll)
+	case "keepall", "phrase":
+		options = options.WithWordBreak(WordBreakKeepAll)
+	}
+
+	return options
+}
+
+// eastAsianLanguages are Chinese, Japanese and Korean languages.
+var eastAsianLanguages = map[string]bool{
+	"ja": true, "ko": true, "zh": true,
+	"cmn": true, "cjy": true, "cpx": true, "czh": true, "czo": true,
+	"gan": true, "hak": true, "hsn": true, "lzh": true, "mnp": true,
+	"nan": true, "wuu": true, "yue": true,
+}
+
+// eastAsianScripts are Han, Japanese, Korean and Bopomofo scripts.
+var eastAsianScripts = map[string]bool{
+	"bopo": true, "hang": true, "hani": true, "hans": true, "hant": true,
+	"hira": true, "hrkt": true, "jpan": true, "kana": true, "kore": true,
+}
+
+// eastAsianRegions imply an East Asian language, where none is given.
+var eastAsianRegions = map[string]bool{
+	"cn": true, "hk": true, "jp": true, "kp": true, "kr": true,
+	"mo": true, "sg": true, "tw": true,
+}
+
+// isEastAsian reports whether lt is written in Chinese, Japanese or Korean
+// script.
+func (lt languageTag) isEastAsian() bool {
+	if lt.script != "" {
+		return eastAsianScripts[lt.script]
+	}
+	if lt.language == "" || lt.language == "und" {
+		return eastAsianRegions[lt.region]
+	}
+	return eastAsianLanguages[lt.language]
+}
+
+// isKorean reports whether lt is written in Korean script.
+func (lt languageTag) isKorean() bool {
+	if lt.script != "" {
+		return lt.script == "kore" || lt.script == "hang"
+	}
+	if lt.language == "" || lt.language == "und" {
+		return lt.region == "kr" || lt.region == "kp"
+	}
+	return lt.language == "ko"
+}
+
+// syllabicLanguages are written in Myanmar or Khmer script.
+var syllabicLanguages = map[string]bool{
+	"km": true, "ksw": true, "mnw": true, "my": true, "shn": true,
+}
+
+// isSyllabic reports whether lt is written in Myanmar or Khmer script.
+func (lt languageTag) isSyllabic() bool {
+	if lt.script != "" {
+		return lt.script == "mymr" || lt.script == "khmr"
+	}
+	return syllabicLanguages[lt.language]
+}
@@ -0,0 +1,69 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestProfileForLanguage(t *testing.T) {
+	tests := []struct {
+		tag   string
+		input string
+		want  []string
+	}{
+		// Default
+		{"", "キャッシュ", []string{"キャッ", "シュ"}},
+		{"en-US", "a①b", []string{"a①b"}},
+		{"not a tag", "a①b", []string{"a①b"}},
+
+		// Chinese, Japanese and Korean
+		{"ja-JP", "キャッシュ", []string{"キ", "ャ", "ッ", "シ", "ュ"}},
+		{"ja-JP", "a①b", []string{"a", "①", "b"}},
+		{"zh-Hant-TW", "a①b", []string{"a", "①", "b"}},
+		{"zh-yue", "a①b", []string{"a", "①", "b"}},
+		{"ko", "a①b", []string{"a", "①", "b"}},
+		{"ko", "Go언어는 發表되었다", []string{"Go언어는 ", "發", "表되었다"}},
+		{"und-KR", "한국어 문장", []string{"한국어 ", "문장"}},
+		{"ko-u-lw-normal", "한국어", []string{"한", "국", "어"}},
+		{"ja", "한국어", []string{"한", "국", "어"}},
+		{"und-JP", "a①b", []string{"a", "①", "b"}},
+		{"und-Hani", "a①b", []string{"a", "①", "b"}},
+		{"ja-Latn", "a①b", []string{"a①b"}},
+		{"en-JP", "a①b", []string{"a①b"}},
+
+		// Extension keys
+		{"ja-JP-u-lb-strict", "キャッシュ", []string{"キャッ", "シュ"}},
+		{"ja-u-lb-loose", "人々", []string{"人", "々"}},
+		{"en-u-lb-normal", "キャッシュ", []string{"キ", "ャ", "ッ", "シ", "ュ"}},
+		{"ko-u-lw-keepall", "한국어 문장", []string{"한국어 ", "문장"}},
+		{"ja-u-lw-phrase", "日本語です", []string{"日本語です"}},
+		{"en-u-lw-breakall", "hello", []string{"h", "e", "l", "l", "o"}},
+		{"ko-u-lw-keepall-lw-normal", "한국어", []string{"한국어"}},
+		{"ja-u-lb-bogus", "キャッシュ", []string{"キ", "ャ", "ッ", "シ", "ュ"}},
+
+		// Quotation conventions
+		{"de", "中„文“字", []string{"中", "„文“", "字"}},
+		{"de-CH", "中«文»字", []string{"中", "«文»", "字"}},
+		{"de-DE", "中»文«字", []string{"中", "»文«", "字"}},
+		{"sv-FI", "中”文”字", []string{"中”文”字"}},
+
+		// Myanmar and Khmer syllables
+		{"my", "မြန်မာ", []string{"မြန်", "မာ"}},
+		{"km-KH", "កម្ពុជា", []string{"ក", "ម្ពុ", "ជា"}},
+		{"en", "မြန်မာ", []string{"မြန်မာ"}},
+
+		// Single-letter words
+		{"cs", "jdu v lese", []string{"jdu ", "v lese"}},
+		{"pl-PL", "i w domu", []string{"i w domu"}},
+		{"en", "jdu v lese", []string{"jdu ", "v ", "lese"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag+"/"+tt.input, func(t *testing.T) {
+			got := segments(tt.input, ProfileForLanguage(tt.tag))
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
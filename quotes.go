@@ -0,0 +1,116 @@
+package uax14
+
+import (
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
+// Quotes is a quotation convention, which determines whether quotation marks
+// (class QU) act as opening or closing marks in rules LB15a, LB15b and LB19.
+//
+// By default, marks with general category Pi (such as “ ‘ «) open and
+// marks with general category Pf (such as ” ’ ») close, which is the
+// English convention. Other languages use the same marks differently,
+// such as „…“ in German, »…« in Danish, or ”…” in Finnish and Swedish.
+type Quotes struct {
+	// Opening marks act as Pi.
+	Opening []rune
+	// Closing marks act as Pf.
+	Closing []rune
+	// Ambiguous marks act as neither, because they both open and close.
+	Ambiguous []rune
+}
+
+// Quotation conventions, by language. Marks which are not QU, such as „
+// (which is OP), are listed for completeness, and have no effect unless
+// reassigned to QU with [Options.WithClassOverrides].
+var quotesByLanguage = map[string]Quotes{
+	// “…” and ‘…’
+	"en": {
+		Opening: []rune{'“', '‘'},
+		Closing: []rune{'”', '’'},
+	},
+	// „…“ and ‚…‘, or »…« and ›…‹
+	"de": {
+		Opening: []rune{'„', '‚', '»', '›'},
+		Closing: []rune{'“', '‘', '«', '‹'},
+	},
+	// »…« and ›…‹, or „…“
+	"da": {
+		Opening: []rune{'»', '›', '„', '‚'},
+		Closing: []rune{'«', '‹', '“', '‘'},
+	},
+	// ”…” and ’…’, or »…»
+	"fi": {
+		Ambiguous: []rune{'”', '’', '»', '›'},
+	},
+	"sv": {
+		Ambiguous: []rune{'”', '’', '»', '›'},
+	},
+	// «…» and ‹…›, as in French but without spaces
+	"de-ch": {
+		Opening: []rune{'«', '‹', '„', '‚'},
+		Closing: []rune{'»', '›', '“', '‘'},
+	},
+	"de-li": {
+		Opening: []rune{'«', '‹', '„', '‚'},
+		Closing: []rune{'»', '›', '“', '‘'},
+	},
+	// « … » and “…”, with narrow no-break spaces inside guillemets
+	"fr": {
+		Opening: []rune{'«', '‹', '“', '‘'},
+		Closing: []rune{'»', '›', '”', '’'},
+	},
+}
+
+// QuotesForLanguage returns the quotation convention of the language of a
+// BCP 47 tag, such as "de", "de-CH" or "sv-FI", and whether one is known.
+// The convention of a language and region is preferred to that of the
+// language alone. The result is a copy, which the caller may modify.
+func QuotesForLanguage(tag string) (Quotes, bool) {
+	quotes, ok := quotesForTag(parseLanguageTag(tag))
+	return Quotes{
+		Opening:   slices.Clone(quotes.Opening),
+		Closing:   slices.Clone(quotes.Closing),
+		Ambiguous: slices.Clone(quotes.Ambiguous),
+	}, ok
+}
+
+func quotesForTag(lt languageTag) (Quotes, bool) {
+	if lt.region != "" {
+		if quotes, ok := quotesByLanguage[lt.language+"-"+lt.region]; ok {
+			return quotes, true
+		}
+	}
+	quotes, ok := quotesByLanguage[lt.language]
+	return quotes, ok
+}
+
+// WithQuotes returns a copy of options, with quotation marks acting as
+// opening or closing marks according to quotes. Marks which quotes does not
+// list keep their default behavior. It replaces any earlier convention.
+//
+// WithQuotes panics if a mark is not a valid Unicode scalar value, or is
+// listed more than once.
+func (options Options) WithQuotes(quotes Quotes) Options {
+	roles := make(map[rune]property, len(quotes.Opening)+len(quotes.Closing)+len(quotes.Ambiguous))
+	add := func(marks []rune, role property) {
+		for _, r := range marks {
+			if !utf8.ValidRune(r) {
+				panic(fmt.Sprintf("uax14: invalid rune %U in quotes", r))
+			}
+			if _, ok := roles[r]; ok {
+				panic(fmt.Sprintf("uax14: quotation mark %U is listed more than once", r))
+			}
+			roles[r] = role
+		}
+	}
+	add(quotes.Opening, _PI)
+	add(quotes.Closing, _PF)
+	add(quotes.Ambiguous, 0)
+
+	return options.tailor(func(t *tailoring) {
+		t.quotes = roles
+	})
+}
@@ -0,0 +1,163 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestQuotes_Languages(t *testing.T) {
+	tests := []struct {
+		language string
+		input    string
+		want     []string
+	}{
+		// German „…“ and »…«: “ and « close
+		{"de", "Er sagte „Ja“ und ging.", []string{"Er ", "sagte ", "„Ja“ ", "und ", "ging."}},
+		{"de", "中„文“字", []string{"中", "„文“", "字"}},
+		{"de", "中»文«字", []string{"中", "»文«", "字"}},
+
+		// Danish »…«: » opens and « closes
+		{"da", "Han sagde »Hej« og gik.", []string{"Han ", "sagde ", "»Hej« ", "og ", "gik."}},
+		{"da", "» Hej «", []string{"» Hej «"}},
+		{"da", "中»文«字", []string{"中", "»文«", "字"}},
+
+		// Finnish and Swedish ”…” and »…»: marks both open and close
+		{"fi", "Hän sanoi ”hei” ja lähti.", []string{"Hän ", "sanoi ", "”hei” ", "ja ", "lähti."}},
+		{"fi", "中”文”字", []string{"中”文”字"}},
+		{"fi", "中»文»字", []string{"中»文»字"}},
+		{"sv", "Han sa ”hej” och gick.", []string{"Han ", "sa ", "”hej” ", "och ", "gick."}},
+		{"sv", "中’文’字", []string{"中’文’字"}},
+
+		// French « … », with spaces or narrow no-break spaces
+		{"fr", "Il a dit « oui » et part.", []string{"Il ", "a ", "dit ", "« oui » ", "et ", "part."}},
+		{"fr", "Il a dit «\u202foui\u202f» et part.", []string{"Il ", "a ", "dit ", "«\u202foui\u202f» ", "et ", "part."}},
+		{"fr", "中«文»字", []string{"中", "«文»", "字"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language+"/"+tt.input, func(t *testing.T) {
+			quotes, ok := QuotesForLanguage(tt.language)
+			if !ok {
+				t.Fatalf("no quotes for %q", tt.language)
+			}
+			options := DefaultOptions.WithQuotes(quotes)
+
+			got := segments(tt.input, options)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			gotR := segments(tt.input, options.WithRuleset(defaultRuleset))
+			if fmt.Sprint(gotR) != fmt.Sprint(tt.want) {
+				t.Fatalf("ruleset: got %q, want %q", gotR, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuotes_Default(t *testing.T) {
+	// The default convention splits quotes from the text they enclose, where
+	// they are used differently from English
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"中„文“字", []string{"中", "„文", "“字"}},
+		{"中»文«字", []string{"中»", "文", "«字"}},
+		{"» Hej «", []string{"» ", "Hej ", "«"}},
+		{"中”文”字", []string{"中”", "文”", "字"}},
+	}
+
+	for _, tt := range tests {
+		got := segments(tt.input, DefaultOptions)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%q: got %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestQuotesForLanguage(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+		ok   bool
+	}{
+		{"de", "de", true},
+		{"de-AT", "de", true},
+		{"de-CH", "de-ch", true},
+		{"de-Latn-LI", "de-li", true},
+		{"sv_FI", "sv", true},
+		{"DA", "da", true},
+		{"en-US", "en", true},
+		{"pt-BR", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := QuotesForLanguage(tt.tag)
+		if ok != tt.ok {
+			t.Errorf("%q: got ok=%v, want %v", tt.tag, ok, tt.ok)
+			continue
+		}
+		if ok && fmt.Sprint(got) != fmt.Sprint(quotesByLanguage[tt.want]) {
+			t.Errorf("%q: got %v, want %s", tt.tag, got, tt.want)
+		}
+	}
+
+	// The result does not share storage with the conventions
+	got, _ := QuotesForLanguage("de")
+	got.Opening[0] = 'x'
+	if again, _ := QuotesForLanguage("de"); again.Opening[0] == 'x' {
+		t.Errorf("modifying a result changed the convention: %v", again)
+	}
+}
+
+func TestQuotes_WithClassOverrides(t *testing.T) {
+	de, _ := QuotesForLanguage("de")
+
+	// „ is OP, so only acts as an opening quote once it is QU
+	a := DefaultOptions.WithQuotes(de).WithClassOverrides(map[rune]Class{'„': QU})
+	b := DefaultOptions.WithClassOverrides(map[rune]Class{'„': QU}).WithQuotes(de)
+	for _, options := range []Options{a, b} {
+		p, _ := lookupPropertyWith("„", options.overrides)
+		if !p.is(_QU) || !p.is(_PI) {
+			t.Errorf("„: got %#x, want QU with Pi", p)
+		}
+		p, _ = lookupPropertyWith("“", options.overrides)
+		if !p.is(_QU) || !p.is(_PF) || p.is(_PI) {
+			t.Errorf("“: got %#x, want QU with Pf", p)
+		}
+	}
+}
+
+func TestQuotes_Replace(t *testing.T) {
+	de, _ := QuotesForLanguage("de")
+	fi, _ := QuotesForLanguage("fi")
+	options := DefaultOptions.WithQuotes(de).WithQuotes(fi)
+
+	// “ is back to its default of Pi
+	p, _ := lookupPropertyWith("“", options.overrides)
+	if !p.is(_PI) || p.is(_PF) {
+		t.Errorf("“: got %#x, want Pi", p)
+	}
+	p, _ = lookupPropertyWith("”", options.overrides)
+	if p.is(_PI | _PF) {
+		t.Errorf("”: got %#x, want neither Pi nor Pf", p)
+	}
+}
+
+func TestQuotes_Panics(t *testing.T) {
+	tests := map[string]Quotes{
+		"invalid":   {Opening: []rune{0xD800}},
+		"duplicate": {Opening: []rune{'“'}, Closing: []rune{'“'}},
+	}
+	for name, quotes := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("should panic")
+				}
+			}()
+			DefaultOptions.WithQuotes(quotes)
+		})
+	}
+}
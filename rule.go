@@ -0,0 +1,112 @@
+package uax14
+
+// Rule is a custom line breaking rule. Rules are registered on [Options]
+// using [Options.WithRule], and are evaluated ahead of the default rules
+// at their chosen [Position].
+type Rule interface {
+	// Decide determines whether to break between ctx.Last and ctx.Current.
+	// Return [Continue] to defer to the remaining rules.
+	Decide(ctx Context) Decision
+}
+
+// RuleFunc adapts an ordinary function to the [Rule] interface.
+type RuleFunc func(ctx Context) Decision
+
+// Decide calls f(ctx).
+func (f RuleFunc) Decide(ctx Context) Decision {
+	return f(ctx)
+}
+
+// Decision is the outcome of a [Rule].
+type Decision uint8
+
+const (
+	// Continue defers to the remaining rules.
+	Continue Decision = iota
+	// Break allows a break (an opportunity) at the current position.
+	Break
+	// NoBreak prohibits a break at the current position.
+	NoBreak
+)
+
+// Position is the point in the default rule chain at which a custom [Rule]
+// is evaluated.
+type Position uint8
+
+const (
+	// BeforeLB7 evaluates the rule after the mandatory breaks (LB4–LB6),
+	// and before all other default rules. At this position, CM and ZWJ have
+	// not yet been absorbed (LB9) or resolved (LB10).
+	BeforeLB7 Position = iota
+	// BeforeLB18 evaluates the rule before the break after spaces (LB18).
+	BeforeLB18
+	// BeforeLB31 evaluates the rule before the default break opportunity
+	// (LB31), i.e. only where no default rule has decided.
+	BeforeLB31
+
+	numPositions
+)
+
+// Context is the state around a candidate break position, as seen by a
+// [Rule]. The candidate break position is between the last character and the
+// current character.
+//
+// Class fields are resolved classes; "Ex" fields exclude the named classes
+// when looking back, as in the UAX #14 rules.
+type Context struct {
+	// Last is the class of the character before the candidate break.
+	Last Class
+	// LastExSP is the class of the last character, skipping back over SP.
+	LastExSP Class
+	// LastExCMZWJ is the class of the last character, skipping back over CM
+	// and ZWJ, i.e. the base that absorbed them (LB9).
+	LastExCMZWJ Class
+	// LastExCMZWJSP is the class of the last character, skipping back over
+	// CM, ZWJ and SP.
+	LastExCMZWJSP Class
+	// Current is the class of the character after the candidate break.
+	Current Class
+	// Next is the class of the character following Current, or 0 at the end
+	// of text.
+	Next Class
+
+	// LastRune is the character before the candidate break.
+	LastRune rune
+	// CurrentRune is the character after the candidate break.
+	CurrentRune rune
+	// NextRune is the character following CurrentRune, or -1 at the end of
+	// text.
+	NextRune rune
+
+	// Pos is the byte offset of the candidate break, relative to the start of
+	// the data passed to the break function.
+	Pos int
+}
+
+// decide evaluates rules in order, returning the first decision which is not
+// [Continue].
+func decide[T ~string | ~[]byte](rules []Rule, ov *overrides, data T, pos, w int, last, lastExSP, lastExCMZWJ, lastExCMZWJSP, current property) Decision {
+	ctx := Context{
+		Last:          Class(last),
+		LastExSP:      Class(lastExSP),
+		LastExCMZWJ:   Class(lastExCMZWJ),
+		LastExCMZWJSP: Class(lastExCMZWJSP),
+		Current:       Class(current),
+		LastRune:      decodeLastRune(data[:pos]),
+		CurrentRune:   decodeRune(data[pos:]),
+		NextRune:      -1,
+		Pos:           pos,
+	}
+	if pos+w < len(data) {
+		next, _ := lookupPropertyWith(data[pos+w:], ov)
+		ctx.Next = Class(next)
+		ctx.NextRune = decodeRune(data[pos+w:])
+	}
+
+	for _, rule := range rules {
+		if d := rule.Decide(ctx); d != Continue {
+			return d
+		}
+	}
+	return Continue
+}
@@ -0,0 +1,142 @@
+package uax14
+
+import (
+	"testing"
+)
+
+func segments[T ~string | ~[]byte](in T, options Options) []T {
+	iter := NewIterator(in)
+	iter.SetOptions(options)
+	var out []T
+	for iter.Next() {
+		out = append(out, iter.Current())
+	}
+	return out
+}
+
+func TestRule_Positions(t *testing.T) {
+	// Never break after / in paths
+	slash := RuleFunc(func(ctx Context) Decision {
+		if ctx.LastRune == '/' {
+			return NoBreak
+		}
+		return Continue
+	})
+	// Always allow a break before #
+	hash := RuleFunc(func(ctx Context) Decision {
+		if ctx.CurrentRune == '#' {
+			return Break
+		}
+		return Continue
+	})
+
+	tests := []struct {
+		name     string
+		in       string
+		position Position
+		rule     Rule
+		want     []string
+	}{
+		{
+			name: "default path",
+			in:   "usr/local/bin",
+			want: []string{"usr/", "local/", "bin"},
+		},
+		{
+			name:     "no break after slash",
+			in:       "usr/local/bin",
+			position: BeforeLB31,
+			rule:     slash,
+			want:     []string{"usr/local/bin"},
+		},
+		{
+			name: "default hash",
+			in:   "abc#1",
+			want: []string{"abc#1"},
+		},
+		{
+			name:     "break before hash",
+			in:       "abc#1",
+			position: BeforeLB7,
+			rule:     hash,
+			want:     []string{"abc", "#1"},
+		},
+		{
+			name:     "break before hash, too late in the chain",
+			in:       "abc#1",
+			position: BeforeLB31,
+			rule:     hash,
+			want:     []string{"abc#1"},
+		},
+		{
+			name:     "no break after space",
+			in:       "a b",
+			position: BeforeLB18,
+			rule: RuleFunc(func(ctx Context) Decision {
+				if ctx.Last.Is(SP) && ctx.LastExSP.Is(AL) && ctx.Next == 0 {
+					return NoBreak
+				}
+				return Continue
+			}),
+			want: []string{"a b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DefaultOptions
+			if tt.rule != nil {
+				options = options.WithRule(tt.position, tt.rule)
+			}
+			got := segments(tt.in, options)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("got %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRule_Context(t *testing.T) {
+	var got []Context
+	options := DefaultOptions.WithRule(BeforeLB31, RuleFunc(func(ctx Context) Decision {
+		got = append(got, ctx)
+		return Continue
+	}))
+	segments("á中", options)
+
+	if len(got) != 1 {
+		t.Fatalf("got %d rule evaluations, want 1", len(got))
+	}
+	ctx := got[0]
+	if !ctx.Last.Is(CM) || !ctx.LastExCMZWJ.Is(AL) || !ctx.Current.Is(ID) {
+		t.Fatalf("unexpected classes: %+v", ctx)
+	}
+	if ctx.LastRune != '́' || ctx.CurrentRune != '中' || ctx.NextRune != -1 || ctx.Next != 0 {
+		t.Fatalf("unexpected runes: %+v", ctx)
+	}
+	if ctx.Pos != 3 {
+		t.Fatalf("Pos = %d, want 3", ctx.Pos)
+	}
+}
+
+func TestRule_Order(t *testing.T) {
+	first := RuleFunc(func(ctx Context) Decision { return NoBreak })
+	second := RuleFunc(func(ctx Context) Decision { return Break })
+
+	options := DefaultOptions.WithRule(BeforeLB31, first).WithRule(BeforeLB31, second)
+	if got := segments("中文", options); len(got) != 1 {
+		t.Fatalf("first rule should win, got %q", got)
+	}
+
+	// Deriving options must not affect the parent
+	parent := DefaultOptions.WithRule(BeforeLB31, second)
+	_ = parent.WithRule(BeforeLB31, first)
+	if got := segments("中文", parent); len(got) != 2 {
+		t.Fatalf("parent options were modified, got %q", got)
+	}
+}
@@ -0,0 +1,545 @@
+package uax14
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Ruleset is a compiled set of line breaking rules, written in the notation
+// of UAX #14. A Ruleset is immutable, and safe to share across goroutines.
+//
+// Use [ParseRuleset] to compile rules, and [Options.WithRuleset] to iterate
+// with them. [DefaultRules] is the default algorithm in this notation, and
+// is a starting point for tailorings.
+type Ruleset struct {
+	rules []compiledRule
+	views []ruleView
+	// maxProgram is the longest program, for sizing matcher scratch space
+	maxProgram int
+	// scratch holds *rulesetScratch, reused across calls to
+	// nextBreakRuleset
+	scratch sync.Pool
+}
+
+// ParseRuleset parses and compiles line breaking rules, one per line, in the
+// notation of https://www.unicode.org/reports/tr14/#Algorithm:
+//
+//	LB13: × CL                      # no break before CL
+//	LB14: OP SP* ×                  # no break after OP, with optional spaces
+//	LB15c: SP ÷ IS NU               # break after SP, before IS NU
+//	LB4: BK !                       # mandatory break after BK
+//	LB9: $X (CM | ZWJ)* → $X        # treat X (CM | ZWJ)* as if it were X
+//	LB10: (CM | ZWJ) → AL           # treat remaining CM and ZWJ as AL
+//	$X = [^BK CR LF NL SP ZW]       # a variable, for use as $X
+//
+// Operators are × (no break), ÷ (break opportunity), ! (mandatory break)
+// and → (treat as, in the two forms above). Either side of × ÷ ! is a
+// sequence of class names, sot, eot, ALL, $variables, and bracketed sets,
+// grouped with ( | ) and repeated with *, + or ?. Bracketed sets combine
+// classes, \p{Pi}, \p{Pf}, $EastAsian and literal characters such as [◌]
+// or [—], with ^ for negation, & for intersection and - for difference.
+//
+// Rules are applied in order of their labels (LB15a after LB15, LB15.5 after
+// LB15d), and in source order for equal labels. A rule without a label takes
+// the label of the rule before it. The first rule to match a position
+// decides; where none matches, a break is allowed. A transform applies to the
+// rules after it. The end of text is always a mandatory break, and the start
+// of text never a break.
+func ParseRuleset(src string) (*Ruleset, error) {
+	parsed, err := parseRules(src)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &Ruleset{views: []ruleView{{}}}
+	for _, pr := range parsed {
+		r := compiledRule{op: pr.op, view: len(rs.views) - 1}
+
+		if pr.op == '→' {
+			prev := rs.views[len(rs.views)-1]
+			v, err := rs.transform(pr)
+			if err != nil {
+				return nil, err
+			}
+			rs.views = append(rs.views, v)
+			if v.absorbed != nil && prev.absorbed == nil {
+				// Absorbing also means no break within X Y*. Other
+				// transforms only change the units seen by later rules.
+				r.op = '×'
+				r.view = len(rs.views) - 1
+				r.absorb = true
+				rs.rules = append(rs.rules, r)
+			}
+			continue
+		}
+
+		if pr.left != nil {
+			r.left = compileProgram(pr.left.reverse())
+		}
+		if pr.right != nil {
+			r.right = compileProgram(pr.right)
+		}
+		rs.maxProgram = max(rs.maxProgram, len(r.left), len(r.right))
+		rs.rules = append(rs.rules, r)
+	}
+	return rs, nil
+}
+
+// MustParseRuleset is like [ParseRuleset], but panics on error.
+func MustParseRuleset(src string) *Ruleset {
+	rs, err := ParseRuleset(src)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// transform compiles a → rule into a view, derived from the latest view.
+func (rs *Ruleset) transform(pr parsedRule) (ruleView, error) {
+	v := rs.views[len(rs.views)-1]
+	v.resolves = v.resolves[:len(v.resolves):len(v.resolves)]
+
+	// Treat X Y* as if it were X
+	if pr.left != nil && pr.left.kind == nodeSeq && len(pr.left.nodes) == 2 {
+		base, rep := pr.left.nodes[0], pr.left.nodes[1]
+		if base.kind == nodeSet && rep.kind == nodeStar && pr.right != nil && pr.right.text == base.text {
+			set, ok := setOf(rep.nodes[0])
+			if !ok {
+				return ruleView{}, fmt.Errorf("line %d: → can only absorb a set of classes", pr.line)
+			}
+			if v.absorbed != nil {
+				return ruleView{}, fmt.Errorf("line %d: only one absorbing → rule is supported", pr.line)
+			}
+			v.base, v.absorbed = base.set, set
+			return v, nil
+		}
+	}
+
+	// Treat X as if it were Y
+	from, ok := setOf(pr.left)
+	to, ok2 := setOf(pr.right)
+	if !ok || !ok2 || to.kind != setClass || to.mask&annotations != 0 {
+		return ruleView{}, fmt.Errorf("line %d: → must be of the form X Y* → X, or X → CLASS", pr.line)
+	}
+	v.resolves = append(v.resolves, ruleResolve{from: from, to: to.mask})
+	return v, nil
+}
+
+// setOf returns the set of a node which matches exactly one unit, such as
+// AL or (CM | ZWJ).
+func setOf(n *ruleNode) (*unitSet, bool) {
+	if n == nil {
+		return nil, false
+	}
+	switch n.kind {
+	case nodeSet:
+		return n.set, true
+	case nodeAlt:
+		union := &unitSet{kind: setUnion}
+		for _, child := range n.nodes {
+			set, ok := setOf(child)
+			if !ok {
+				return nil, false
+			}
+			union.sets = append(union.sets, set)
+		}
+		return union, true
+	}
+	return nil, false
+}
+
+// WithRuleset returns a copy of options, which breaks text using rs in place
+// of the built-in rules. Rules added with [Options.WithRule] do not apply to
+// a ruleset; express them in the ruleset instead.
+func (options Options) WithRuleset(rs *Ruleset) Options {
+	options.ruleset = rs
+	return options
+}
+
+// compiledRule is a rule ready for matching.
+type compiledRule struct {
+	op          rune // ×, ÷ or !
+	view        int  // index of the view in which to match
+	left, right program
+	// absorb is the implicit × within an absorbing transform, e.g. LB9
+	absorb bool
+}
+
+// ruleView is the sequence of units seen by rules, following transforms.
+type ruleView struct {
+	base, absorbed *unitSet
+	resolves       []ruleResolve
+}
+
+// ruleResolve treats units in from as if they were the class to.
+type ruleResolve struct {
+	from *unitSet
+	to   property
+}
+
+// ruleUnitKind distinguishes characters from the start and end of text.
+type ruleUnitKind uint8
+
+const (
+	unitChar ruleUnitKind = iota
+	unitSot
+	unitEot
+)
+
+// ruleUnit is a character, or a sequence of characters treated as one
+// following a transform, or the start or end of text.
+type ruleUnit struct {
+	kind       ruleUnitKind
+	class      property
+	r          rune
+	start, end int
+}
+
+// instOp is an instruction of a program.
+type instOp uint8
+
+const (
+	opSet   instOp = iota // consume a unit in set, continue at pc+1
+	opSplit               // continue at both x and y
+	opJmp                 // continue at x
+	opMatch               // the pattern has matched
+)
+
+type inst struct {
+	op   instOp
+	set  *unitSet
+	x, y int
+}
+
+// program is a compiled pattern, matched as an NFA over units.
+type program []inst
+
+func compileProgram(n *ruleNode) program {
+	var prog program
+	prog = emit(prog, n)
+	return append(prog, inst{op: opMatch})
+}
+
+func emit(prog program, n *ruleNode) program {
+	switch n.kind {
+	case nodeSet:
+		prog = append(prog, inst{op: opSet, set: n.set})
+	case nodeSeq:
+		for _, child := range n.nodes {
+			prog = emit(prog, child)
+		}
+	case nodeAlt:
+		var jumps []int
+		for i, child := range n.nodes {
+			if i == len(n.nodes)-1 {
+				prog = emit(prog, child)
+				break
+			}
+			split := len(prog)
+			prog = append(prog, inst{op: opSplit, x: split + 1})
+			prog = emit(prog, child)
+			jumps = append(jumps, len(prog))
+			prog = append(prog, inst{op: opJmp})
+			prog[split].y = len(prog)
+		}
+		for _, j := range jumps {
+			prog[j].x = len(prog)
+		}
+	case nodeStar:
+		split := len(prog)
+		prog = append(prog, inst{op: opSplit, x: split + 1})
+		prog = emit(prog, n.nodes[0])
+		prog = append(prog, inst{op: opJmp, x: split})
+		prog[split].y = len(prog)
+	case nodePlus:
+		start := len(prog)
+		prog = emit(prog, n.nodes[0])
+		prog = append(prog, inst{op: opSplit, x: start, y: len(prog) + 1})
+	case nodeOpt:
+		split := len(prog)
+		prog = append(prog, inst{op: opSplit, x: split + 1})
+		prog = emit(prog, n.nodes[0])
+		prog[split].y = len(prog)
+	}
+	return prog
+}
+
+// rulesetState is the state of matching a ruleset at successive positions
+// in data.
+type rulesetState[T ~string | ~[]byte] struct {
+	rs   *Ruleset
+	ov   *overrides
+	data T
+	pos  int
+	*rulesetScratch
+}
+
+// rulesetScratch is the space of a rulesetState which does not depend on
+// the data, and is reused from one call to nextBreakRuleset to the next.
+type rulesetScratch struct {
+	// left and right units, per view, cached for the current position
+	left, right [][]ruleUnit
+	// matcher scratch; marks[pc] == gen when pc is already in a list
+	clist, nlist []int
+	marks        []int
+	gen          int
+}
+
+// nextBreakRuleset is the equivalent of nextBreak, using a ruleset.
+func nextBreakRuleset[T ~string | ~[]byte](data T, options *Options, c *constraints) (advance int, kind breakKind) {
+	if len(data) == 0 {
+		return 0, breakMandatory
+	}
+	rs, ov := options.ruleset, options.overrides
+
+	scratch, _ := rs.scratch.Get().(*rulesetScratch)
+	if scratch == nil {
+		scratch = &rulesetScratch{
+			left:  make([][]ruleUnit, len(rs.views)),
+			right: make([][]ruleUnit, len(rs.views)),
+			clist: make([]int, 0, rs.maxProgram),
+			nlist: make([]int, 0, rs.maxProgram),
+			marks: make([]int, rs.maxProgram),
+		}
+	}
+	defer rs.scratch.Put(scratch)
+	st := rulesetState[T]{rs: rs, ov: ov, data: data, rulesetScratch: scratch}
+
+	// LB2: start of text always advances
+	_, w := st.charAt(0)
+	pos := w
+	clusters := graphemeCursor[T]{data: data}
+	for pos < len(data) {
+		st.reset(pos)
+		held, allowed := c.noBreakAt(pos), c.allowBreakAt(pos)
+		if !held && !allowed && options.graphemes && !clusters.isBoundary(pos) {
+			_, w := st.charAt(pos)
+			pos += w
+			continue
+		}
+		decision := st.decide()
+		switch {
+		case decision == '!':
+			return pos, breakMandatory
+		case held:
+			// Caller constraints, see [Iterator.SetConstraints]
+		case allowed:
+			// Not before a line terminator, as LB6
+			if p, _ := lookupPropertyWith(data[pos:], ov); !p.is(_BK | _CR | _LF | _NL) {
+				return pos, breakOpportunity
+			}
+		case decision == '÷' && !st.keepAll() && !afterSingleLetter(data, pos, ov, c) && !frenchSpaceAt(data, pos, ov):
+			return pos, breakOpportunity
+		}
+		_, w := st.charAt(pos)
+		pos += w
+	}
+
+	// LB3
+	return len(data), breakMandatory
+}
+
+// keepAll reports whether a break at st.pos is suppressed by
+// [WordBreakKeepAll] or [WordBreakKorean], as seen in the last view.
+func (st *rulesetState[T]) keepAll() bool {
+	if st.ov == nil || !st.ov.keepAll && !st.ov.korean {
+		return false
+	}
+	vi := len(st.rs.views) - 1
+	left, ok := st.unit(vi, true, 0)
+	if !ok || left.kind != unitChar {
+		return false
+	}
+	right, ok := st.unit(vi, false, 0)
+	return ok && right.kind == unitChar && st.ov.keeps(left.class, right.class)
+}
+
+func (st *rulesetState[T]) reset(pos int) {
+	st.pos = pos
+	for i := range st.left {
+		st.left[i] = st.left[i][:0]
+		st.right[i] = st.right[i][:0]
+	}
+}
+
+// decide returns the operator of the first rule to match at st.pos, or ÷ if
+// none matches.
+func (st *rulesetState[T]) decide() rune {
+	for i := range st.rs.rules {
+		r := &st.rs.rules[i]
+		if r.absorb {
+			// Within X Y*, where the current character is in Y, and
+			// the unit to the left (X Y*) is in X.
+			v := &st.rs.views[r.view]
+			c, _ := st.charAt(st.pos)
+			if v.absorbed.matches(c) {
+				if u, ok := st.unit(r.view, true, 0); ok && v.base.matches(u) {
+					return r.op
+				}
+			}
+			continue
+		}
+		if st.match(r.left, r.view, true) && st.match(r.right, r.view, false) {
+			return r.op
+		}
+	}
+	return '÷'
+}
+
+// charAt returns the unit of the single character at i, and its width.
+func (st *rulesetState[T]) charAt(i int) (ruleUnit, int) {
+	p, w := lookupWith(st.data[i:], st.ov)
+	if w == 0 {
+		w = len(st.data) - i
+	}
+	if p == 0 {
+		p = _AL
+	}
+	return ruleUnit{class: p, r: decodeRune(st.data[i:]), start: i, end: i + w}, w
+}
+
+// charBefore returns the unit of the single character ending at end.
+func (st *rulesetState[T]) charBefore(end int) ruleUnit {
+	start := end - 1
+	for start > 0 && end-start < 4 && st.data[start]&0xC0 == 0x80 {
+		start--
+	}
+	if u, w := st.charAt(start); w == end-start {
+		return u
+	}
+	return ruleUnit{class: _AL, r: decodeRune(st.data[end-1:]), start: end - 1, end: end}
+}
+
+// unit returns the k'th unit to the left or right of st.pos, as seen in the
+// given view. Units beyond the start or end of text are sot or eot, and
+// then none.
+func (st *rulesetState[T]) unit(vi int, left bool, k int) (ruleUnit, bool) {
+	cache := &st.right[vi]
+	if left {
+		cache = &st.left[vi]
+	}
+	for len(*cache) <= k {
+		var prev ruleUnit
+		if n := len(*cache); n > 0 {
+			prev = (*cache)[n-1]
+			if prev.kind != unitChar {
+				return ruleUnit{}, false
+			}
+		} else {
+			prev = ruleUnit{start: st.pos, end: st.pos}
+		}
+
+		var u ruleUnit
+		switch {
+		case left && prev.start == 0:
+			u = ruleUnit{kind: unitSot}
+		case !left && prev.end == len(st.data):
+			u = ruleUnit{kind: unitEot, start: prev.end, end: prev.end}
+		case left:
+			u = st.unitBefore(vi, prev.start)
+		default:
+			u = st.unitAfter(vi, prev.end)
+		}
+		*cache = append(*cache, u)
+	}
+	return (*cache)[k], true
+}
+
+// unitBefore returns the unit ending at end, as seen in the given view.
+func (st *rulesetState[T]) unitBefore(vi int, end int) ruleUnit {
+	v := &st.rs.views[vi]
+	u := st.charBefore(end)
+	if v.absorbed != nil && v.absorbed.matches(u) {
+		// Find the base of Y*, or else the start of the run of Y
+		run := u
+		for run.start > 0 {
+			prev := st.charBefore(run.start)
+			if !v.absorbed.matches(prev) {
+				if v.base.matches(prev) {
+					run = prev
+				}
+				break
+			}
+			run = prev
+		}
+		if v.base.matches(run) {
+			u.class, u.r, u.start = run.class, run.r, run.start
+		}
+	}
+	return v.resolve(u)
+}
+
+// unitAfter returns the unit starting at start, as seen in the given view.
+func (st *rulesetState[T]) unitAfter(vi int, start int) ruleUnit {
+	v := &st.rs.views[vi]
+	u, _ := st.charAt(start)
+	if v.absorbed != nil && v.base.matches(u) {
+		for u.end < len(st.data) {
+			next, w := st.charAt(u.end)
+			if !v.absorbed.matches(next) {
+				break
+			}
+			u.end += w
+		}
+	}
+	return v.resolve(u)
+}
+
+func (v *ruleView) resolve(u ruleUnit) ruleUnit {
+	for _, r := range v.resolves {
+		if r.from.matches(u) {
+			u.class = u.class&annotations | r.to
+		}
+	}
+	return u
+}
+
+// match determines whether prog matches units leftward or rightward from
+// st.pos. A nil program matches.
+func (st *rulesetState[T]) match(prog program, vi int, left bool) bool {
+	if prog == nil {
+		return true
+	}
+	st.gen++
+	st.clist = st.clist[:0]
+	if st.add(prog, &st.clist, 0) {
+		return true
+	}
+	for k := 0; len(st.clist) > 0; k++ {
+		u, ok := st.unit(vi, left, k)
+		if !ok {
+			return false
+		}
+		st.gen++
+		st.nlist = st.nlist[:0]
+		for _, pc := range st.clist {
+			in := &prog[pc]
+			if in.op == opSet && in.set.matches(u) && st.add(prog, &st.nlist, pc+1) {
+				return true
+			}
+		}
+		st.clist, st.nlist = st.nlist, st.clist
+	}
+	return false
+}
+
+// add adds pc and the instructions reachable from it without consuming a
+// unit to list, and reports whether the match instruction is reachable.
+func (st *rulesetState[T]) add(prog program, list *[]int, pc int) bool {
+	if st.marks[pc] == st.gen {
+		return false
+	}
+	st.marks[pc] = st.gen
+
+	switch in := &prog[pc]; in.op {
+	case opMatch:
+		return true
+	case opJmp:
+		return st.add(prog, list, in.x)
+	case opSplit:
+		return st.add(prog, list, in.x) || st.add(prog, list, in.y)
+	default:
+		*list = append(*list, pc)
+		return false
+	}
+}
@@ -0,0 +1,142 @@
+package uax14
+
+// DefaultRules is the default line breaking algorithm of UAX #14, in the
+// notation accepted by [ParseRuleset]. Tailorings may be appended, with
+// labels that place them among the default rules.
+//
+// See https://www.unicode.org/reports/tr14/#Algorithm.
+const DefaultRules = `
+# LB1 class resolution is applied by the generated lookup data:
+# AI, SG and XX as AL, CJ as NS, SA as CM or AL.
+
+$X = [^BK CR LF NL SP ZW]
+
+LB2: sot ×
+LB3: ! eot
+
+LB4: BK !
+
+LB5: CR × LF
+     CR !
+     LF !
+     NL !
+
+LB6: × ( BK | CR | LF | NL )
+
+LB7: × SP
+     × ZW
+
+LB8: ZW SP* ÷
+
+LB8a: ZWJ ×
+
+LB9: $X (CM | ZWJ)* → $X
+
+LB10: (CM | ZWJ) → AL
+
+LB11: × WJ
+      WJ ×
+
+LB12: GL ×
+
+LB12a: [^SP BA HY HH] × GL
+
+LB13: × CL
+      × CP
+      × EX
+      × SY
+
+LB14: OP SP* ×
+
+LB15a: (sot | BK | CR | LF | NL | OP | QU | GL | SP | ZW) [\p{Pi}&QU] SP* ×
+
+LB15b: × [\p{Pf}&QU] ( SP | GL | WJ | CL | QU | CP | EX | IS | SY | BK | CR | LF | NL | ZW | eot )
+
+LB15c: SP ÷ IS NU
+
+LB15d: × IS
+
+LB16: (CL | CP) SP* × NS
+
+LB17: B2 SP* × B2
+
+LB18: SP ÷
+
+LB19: × [ QU - \p{Pi} ]
+      [ QU - \p{Pf} ] ×
+
+LB19a: [^$EastAsian] × QU
+       × QU ( [^$EastAsian] | eot )
+       QU × [^$EastAsian]
+       ( sot | [^$EastAsian] ) QU ×
+
+LB20: ÷ CB
+      CB ÷
+
+LB20a: ( sot | BK | CR | LF | NL | SP | ZW | CB | GL ) ( HY | HH ) × ( AL | HL )
+
+LB21: × BA
+      × HH
+      × HY
+      × NS
+      BB ×
+
+LB21a: HL (HY | HH) × [^HL]
+
+LB21b: SY × HL
+
+LB22: × IN
+
+LB23: (AL | HL) × NU
+      NU × (AL | HL)
+
+LB23a: PR × (ID | EB | EM)
+       (ID | EB | EM) × PO
+
+LB24: (PR | PO) × (AL | HL)
+      (AL | HL) × (PR | PO)
+
+LB25: NU ( SY | IS )* CL × PO
+      NU ( SY | IS )* CP × PO
+      NU ( SY | IS )* CL × PR
+      NU ( SY | IS )* CP × PR
+      NU ( SY | IS )* × PO
+      NU ( SY | IS )* × PR
+      PO × OP NU
+      PO × OP IS NU
+      PO × NU
+      PR × OP NU
+      PR × OP IS NU
+      PR × NU
+      HY × NU
+      IS × NU
+      NU ( SY | IS )* × NU
+
+LB26: JL × (JL | JV | H2 | H3)
+      (JV | H2) × (JV | JT)
+      (JT | H3) × JT
+
+LB27: (JL | JV | JT | H2 | H3) × PO
+      PR × (JL | JV | JT | H2 | H3)
+
+LB28: (AL | HL) × (AL | HL)
+
+LB28a: AP × (AK | [◌] | AS)
+       (AK | [◌] | AS) × (VF | VI)
+       (AK | [◌] | AS) VI × (AK | [◌])
+       (AK | [◌] | AS) × (AK | [◌] | AS) VF
+
+LB29: IS × (AL | HL)
+
+LB30: (AL | HL | NU) × [OP-$EastAsian]
+      [CP-$EastAsian] × (AL | HL | NU)
+
+LB30a: sot (RI RI)* RI × RI
+       [^RI] (RI RI)* RI × RI
+
+LB30b: EB × EM
+       [\p{Extended_Pictographic}&\p{Cn}] × EM
+
+LB31: ALL ÷
+      ÷ ALL
+`
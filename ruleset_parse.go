@@ -0,0 +1,605 @@
+package uax14
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// classNames maps line breaking class names, as used in rule notation, to
+// their property bits.
+var classNames = map[string]property{
+	"AK": _AK, "AL": _AL, "AP": _AP, "AS": _AS, "B2": _B2, "BA": _BA,
+	"BB": _BB, "BK": _BK, "CB": _CB, "CL": _CL, "CM": _CM, "CP": _CP,
+	"CR": _CR, "EB": _EB, "EM": _EM, "EX": _EX, "GL": _GL, "H2": _H2,
+	"H3": _H3, "HH": _HH, "HL": _HL, "HY": _HY, "ID": _ID, "IN": _IN,
+	"IS": _IS, "JL": _JL, "JT": _JT, "JV": _JV, "LF": _LF, "NL": _NL,
+	"NS": _NS, "NU": _NU, "OP": _OP, "PO": _PO, "PR": _PR, "QU": _QU,
+	"RI": _RI, "SP": _SP, "SY": _SY, "VF": _VF, "VI": _VI, "WJ": _WJ,
+	"ZW": _ZW, "ZWJ": _ZWJ,
+}
+
+// annotations are property bits which are not line breaking classes,
+// but qualify them.
+const annotations = _AI | _CJ | _SA | _DC | _EA | _EPU | _PF | _PI
+
+// setKind is the kind of a unitSet.
+type setKind uint8
+
+const (
+	setClass     setKind = iota // units intersecting mask
+	setAll                      // ALL: any unit, excluding sot and eot
+	setSot                      // sot
+	setEot                      // eot
+	setRunes                    // literal characters
+	setUnion                    // any of sets
+	setIntersect                // all of sets
+	setDiff                     // sets[0] and not sets[1]
+	setNot                      // not sets[0], excluding sot and eot
+	setPartial                  // a property only supported in an intersection
+)
+
+// unitSet is a predicate on units, such as (AL | HL) or [^$EastAsian].
+type unitSet struct {
+	kind  setKind
+	mask  property
+	runes []rune
+	sets  []*unitSet
+	name  string // for setPartial
+}
+
+func (s *unitSet) matches(u ruleUnit) bool {
+	switch s.kind {
+	case setClass:
+		return u.kind == unitChar && u.class.is(s.mask)
+	case setAll:
+		return u.kind == unitChar
+	case setSot:
+		return u.kind == unitSot
+	case setEot:
+		return u.kind == unitEot
+	case setRunes:
+		if u.kind != unitChar {
+			return false
+		}
+		for _, r := range s.runes {
+			if r == u.r {
+				return true
+			}
+		}
+		return false
+	case setUnion:
+		for _, sub := range s.sets {
+			if sub.matches(u) {
+				return true
+			}
+		}
+		return false
+	case setIntersect:
+		for _, sub := range s.sets {
+			if !sub.matches(u) {
+				return false
+			}
+		}
+		return true
+	case setDiff:
+		return s.sets[0].matches(u) && !s.sets[1].matches(u)
+	case setNot:
+		return u.kind == unitChar && !s.sets[0].matches(u)
+	default:
+		return false
+	}
+}
+
+// nodeKind is the kind of a ruleNode.
+type nodeKind uint8
+
+const (
+	nodeSet  nodeKind = iota // a single unit
+	nodeSeq                  // nodes in sequence
+	nodeAlt                  // any one of nodes
+	nodeStar                 // node*
+	nodePlus                 // node+
+	nodeOpt                  // node?
+)
+
+// ruleNode is a node of a parsed rule pattern, a regular expression over units.
+type ruleNode struct {
+	kind  nodeKind
+	set   *unitSet
+	nodes []*ruleNode
+	text  string
+}
+
+// reverse returns the node with sequences reversed, for matching leftward
+// from a break position.
+func (n *ruleNode) reverse() *ruleNode {
+	if n == nil || n.kind == nodeSet {
+		return n
+	}
+	out := &ruleNode{kind: n.kind, text: n.text, nodes: make([]*ruleNode, len(n.nodes))}
+	for i, child := range n.nodes {
+		out.nodes[i] = child.reverse()
+	}
+	if n.kind == nodeSeq {
+		for i, j := 0, len(out.nodes)-1; i < j; i, j = i+1, j-1 {
+			out.nodes[i], out.nodes[j] = out.nodes[j], out.nodes[i]
+		}
+	}
+	return out
+}
+
+// ruleLabel orders rules, e.g. LB15a or LB21.5.
+type ruleLabel struct {
+	number float64
+	suffix string
+}
+
+func (l ruleLabel) less(other ruleLabel) bool {
+	if l.number != other.number {
+		return l.number < other.number
+	}
+	return l.suffix < other.suffix
+}
+
+// parsedRule is a rule statement before compilation.
+type parsedRule struct {
+	label ruleLabel
+	line  int
+	op    rune
+	left  *ruleNode
+	right *ruleNode
+}
+
+type ruleParser struct {
+	src  []rune
+	pos  int
+	line int
+	vars map[string]*unitSet
+}
+
+func parseRules(src string) ([]parsedRule, error) {
+	p := &ruleParser{
+		vars: map[string]*unitSet{
+			"EastAsian": {kind: setClass, mask: _EA},
+		},
+	}
+
+	var rules []parsedRule
+	current := ruleLabel{}
+	for i, line := range strings.Split(src, "\n") {
+		p.src = []rune(line)
+		p.pos = 0
+		p.line = i + 1
+
+		rule, ok, err := p.parseLine(&current)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	// Rules apply in label order, and in source order for equal labels.
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].label.less(rules[j].label)
+	})
+	return rules, nil
+}
+
+func (p *ruleParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("line %d, column %d: %s", p.line, p.pos+1, fmt.Sprintf(format, args...))
+}
+
+func (p *ruleParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *ruleParser) peek() rune {
+	p.skipSpace()
+	if p.pos >= len(p.src) || p.src[p.pos] == '#' {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *ruleParser) accept(r rune) bool {
+	if p.peek() == r {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+}
+
+func (p *ruleParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) && isIdentRune(p.src[p.pos]) {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// parseLine parses one statement: an assignment, a rule, or a transform.
+// A rule without a label takes the label of the rule before it.
+func (p *ruleParser) parseLine(current *ruleLabel) (parsedRule, bool, error) {
+	if p.peek() == 0 {
+		return parsedRule{}, false, nil
+	}
+
+	// Assignment, e.g. $X = [^BK CR LF NL SP ZW]
+	if p.peek() == '$' {
+		save := p.pos
+		p.pos++
+		name := p.ident()
+		if name != "" && p.accept('=') {
+			set, err := p.parseSetExpr()
+			if err != nil {
+				return parsedRule{}, false, err
+			}
+			if p.peek() != 0 {
+				return parsedRule{}, false, p.errorf("unexpected %q after assignment", p.src[p.pos])
+			}
+			p.vars[name] = set
+			return parsedRule{}, false, nil
+		}
+		p.pos = save
+	}
+
+	// Label, e.g. LB15a:
+	if save := p.pos; p.peek() == 'L' {
+		start := p.pos
+		for p.pos < len(p.src) && (isIdentRune(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		name := string(p.src[start:p.pos])
+		if p.accept(':') {
+			l, err := parseLabel(name)
+			if err != nil {
+				return parsedRule{}, false, p.errorf("%v", err)
+			}
+			*current = l
+		} else {
+			p.pos = save
+		}
+	}
+
+	rule := parsedRule{label: *current, line: p.line}
+	left, err := p.parseSeq()
+	if err != nil {
+		return parsedRule{}, false, err
+	}
+	switch op := p.peek(); op {
+	case '×', '÷', '!', '→':
+		p.pos++
+		rule.op = op
+	case 0:
+		return parsedRule{}, false, p.errorf("missing ×, ÷, ! or →")
+	default:
+		return parsedRule{}, false, p.errorf("unexpected %q", op)
+	}
+	right, err := p.parseSeq()
+	if err != nil {
+		return parsedRule{}, false, err
+	}
+	if p.peek() != 0 {
+		return parsedRule{}, false, p.errorf("unexpected %q", p.src[p.pos])
+	}
+	rule.left, rule.right = left, right
+	return rule, true, nil
+}
+
+func parseLabel(name string) (ruleLabel, error) {
+	digits := strings.TrimPrefix(name, "LB")
+	i := 0
+	for i < len(digits) && ('0' <= digits[i] && digits[i] <= '9' || digits[i] == '.') {
+		i++
+	}
+	if len(digits) == len(name) || i == 0 {
+		return ruleLabel{}, fmt.Errorf("invalid label %q, want e.g. LB13 or LB15a", name)
+	}
+	n, err := strconv.ParseFloat(digits[:i], 64)
+	if err != nil {
+		return ruleLabel{}, fmt.Errorf("invalid label %q: %w", name, err)
+	}
+	return ruleLabel{number: n, suffix: digits[i:]}, nil
+}
+
+// parseSeq parses a sequence of elements, until an operator, | or ).
+func (p *ruleParser) parseSeq() (*ruleNode, error) {
+	start := p.pos
+	seq := &ruleNode{kind: nodeSeq}
+	for {
+		switch p.peek() {
+		case 0, '×', '÷', '!', '→', '|', ')':
+			seq.text = strings.TrimSpace(string(p.src[start:p.pos]))
+			if len(seq.nodes) == 0 {
+				return nil, nil
+			}
+			if len(seq.nodes) == 1 {
+				return seq.nodes[0], nil
+			}
+			return seq, nil
+		}
+		n, err := p.parseElement()
+		if err != nil {
+			return nil, err
+		}
+		seq.nodes = append(seq.nodes, n)
+	}
+}
+
+// parseElement parses an atom with optional repetition.
+func (p *ruleParser) parseElement() (*ruleNode, error) {
+	start := p.pos
+	var n *ruleNode
+	if p.accept('(') {
+		alt := &ruleNode{kind: nodeAlt}
+		for {
+			seq, err := p.parseSeq()
+			if err != nil {
+				return nil, err
+			}
+			if seq == nil {
+				return nil, p.errorf("empty alternative")
+			}
+			alt.nodes = append(alt.nodes, seq)
+			if p.accept(')') {
+				break
+			}
+			if !p.accept('|') {
+				return nil, p.errorf("missing )")
+			}
+		}
+		n = alt
+		if len(alt.nodes) == 1 {
+			n = alt.nodes[0]
+		}
+	} else {
+		set, err := p.parseSetExpr()
+		if err != nil {
+			return nil, err
+		}
+		n = &ruleNode{kind: nodeSet, set: set}
+	}
+
+	for {
+		var kind nodeKind
+		switch {
+		case p.accept('*'):
+			kind = nodeStar
+		case p.accept('+'):
+			kind = nodePlus
+		case p.accept('?'):
+			kind = nodeOpt
+		default:
+			n.text = strings.TrimSpace(string(p.src[start:p.pos]))
+			return n, nil
+		}
+		n = &ruleNode{kind: kind, nodes: []*ruleNode{n}}
+	}
+}
+
+// parseSetExpr parses a class name, ALL, sot, eot, a $variable or a
+// bracketed set.
+func (p *ruleParser) parseSetExpr() (*unitSet, error) {
+	switch r := p.peek(); {
+	case r == '[':
+		return p.parseBracket()
+	case r == '$':
+		p.pos++
+		name := p.ident()
+		set, ok := p.vars[name]
+		if !ok {
+			return nil, p.errorf("undefined variable $%s", name)
+		}
+		return set, nil
+	case isIdentRune(r):
+		name := p.ident()
+		switch name {
+		case "ALL":
+			return &unitSet{kind: setAll}, nil
+		case "sot":
+			return &unitSet{kind: setSot}, nil
+		case "eot":
+			return &unitSet{kind: setEot}, nil
+		}
+		return p.class(name)
+	case r == 0:
+		return nil, p.errorf("unexpected end of rule")
+	default:
+		return nil, p.errorf("unexpected %q", r)
+	}
+}
+
+func (p *ruleParser) class(name string) (*unitSet, error) {
+	mask, ok := classNames[name]
+	if !ok {
+		switch name {
+		case "AI", "SG", "XX", "CJ", "SA":
+			return nil, p.errorf("class %s is resolved by LB1, and cannot be used in rules", name)
+		}
+		return nil, p.errorf("unknown class %q", name)
+	}
+	return &unitSet{kind: setClass, mask: mask}, nil
+}
+
+// parseBracket parses a set in UnicodeSet-like notation, e.g. [^SP BA HY HH],
+// [QU - \p{Pi}] or [\p{Pi}&QU]. Space-separated items form a union; & and -
+// combine unions, left to right.
+func (p *ruleParser) parseBracket() (*unitSet, error) {
+	if !p.accept('[') {
+		return nil, p.errorf("missing [")
+	}
+	negate := p.accept('^')
+
+	set, err := p.parseUnion()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept('&'):
+			rhs, err := p.parseUnion()
+			if err != nil {
+				return nil, err
+			}
+			set = intersect(set, rhs)
+		case p.accept('-'):
+			rhs, err := p.parseUnion()
+			if err != nil {
+				return nil, err
+			}
+			set = &unitSet{kind: setDiff, sets: []*unitSet{set, rhs}}
+		case p.accept(']'):
+			if name := partialName(set); name != "" {
+				return nil, p.errorf(`\p{%s} is only supported as [\p{Extended_Pictographic}&\p{Cn}]`, name)
+			}
+			if negate {
+				set = &unitSet{kind: setNot, sets: []*unitSet{set}}
+			}
+			return set, nil
+		default:
+			return nil, p.errorf("missing ]")
+		}
+	}
+}
+
+func (p *ruleParser) parseUnion() (*unitSet, error) {
+	union := &unitSet{kind: setUnion}
+	var runes []rune
+	for {
+		switch r := p.peek(); {
+		case r == 0:
+			return nil, p.errorf("missing ]")
+		case r == ']' || r == '&' || r == '-':
+			if len(runes) > 0 {
+				union.sets = append(union.sets, &unitSet{kind: setRunes, runes: runes})
+			}
+			if len(union.sets) == 0 {
+				return nil, p.errorf("empty set")
+			}
+			if len(union.sets) == 1 {
+				return union.sets[0], nil
+			}
+			return union, nil
+		case r == '[' || r == '$' || ('A' <= r && r <= 'Z'):
+			set, err := p.parseSetExpr()
+			if err != nil {
+				return nil, err
+			}
+			union.sets = append(union.sets, set)
+		case r == '\\':
+			p.pos++
+			if p.pos < len(p.src) && p.src[p.pos] == 'p' {
+				p.pos++
+				set, err := p.parseProperty()
+				if err != nil {
+					return nil, err
+				}
+				union.sets = append(union.sets, set)
+				continue
+			}
+			r, err := p.parseEscape()
+			if err != nil {
+				return nil, err
+			}
+			runes = append(runes, r)
+		default:
+			p.pos++
+			runes = append(runes, r)
+		}
+	}
+}
+
+// parseProperty parses the body of \p{...}.
+func (p *ruleParser) parseProperty() (*unitSet, error) {
+	if p.pos >= len(p.src) || p.src[p.pos] != '{' {
+		return nil, p.errorf(`missing { after \p`)
+	}
+	end := p.pos + 1
+	for end < len(p.src) && p.src[end] != '}' {
+		end++
+	}
+	if end == len(p.src) {
+		return nil, p.errorf(`missing } after \p{`)
+	}
+	name := string(p.src[p.pos+1 : end])
+	p.pos = end + 1
+
+	switch name {
+	case "Pi":
+		return &unitSet{kind: setClass, mask: _PI}, nil
+	case "Pf":
+		return &unitSet{kind: setClass, mask: _PF}, nil
+	case "Extended_Pictographic", "Cn":
+		return &unitSet{kind: setPartial, name: name}, nil
+	}
+	return nil, p.errorf(`unsupported property \p{%s}`, name)
+}
+
+// parseEscape parses \uXXXX, \x{X...} or an escaped literal character.
+func (p *ruleParser) parseEscape() (rune, error) {
+	if p.pos >= len(p.src) {
+		return 0, p.errorf("incomplete escape")
+	}
+	var hex string
+	switch p.src[p.pos] {
+	case 'u':
+		if p.pos+5 > len(p.src) {
+			return 0, p.errorf(`incomplete \u escape`)
+		}
+		hex = string(p.src[p.pos+1 : p.pos+5])
+		p.pos += 5
+	case 'x':
+		end := p.pos + 1
+		for end < len(p.src) && p.src[end] != '}' {
+			end++
+		}
+		if p.pos+1 >= len(p.src) || p.src[p.pos+1] != '{' || end == len(p.src) {
+			return 0, p.errorf(`invalid \x escape, want \x{...}`)
+		}
+		hex = string(p.src[p.pos+2 : end])
+		p.pos = end + 1
+	default:
+		r := p.src[p.pos]
+		p.pos++
+		return r, nil
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || !utf8.ValidRune(rune(n)) {
+		return 0, p.errorf("invalid code point %q", hex)
+	}
+	return rune(n), nil
+}
+
+// intersect combines a and b, recognizing [\p{Extended_Pictographic}&\p{Cn}],
+// for which lookup data carries a dedicated bit.
+func intersect(a, b *unitSet) *unitSet {
+	if a.kind == setPartial && b.kind == setPartial && a.name != b.name {
+		return &unitSet{kind: setClass, mask: _EPU}
+	}
+	return &unitSet{kind: setIntersect, sets: []*unitSet{a, b}}
+}
+
+// partialName returns the name of a setPartial within set, if any.
+func partialName(set *unitSet) string {
+	if set.kind == setPartial {
+		return set.name
+	}
+	for _, sub := range set.sets {
+		if name := partialName(sub); name != "" {
+			return name
+		}
+	}
+	return ""
+}
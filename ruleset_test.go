@@ -0,0 +1,132 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRuleset_DefaultRulesMatchNextBreak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping conformance test in short mode")
+	}
+
+	rs, err := ParseRuleset(DefaultRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := DefaultOptions.WithRuleset(rs)
+
+	// Cases which the conformance tests do not cover, followed by those
+	cases := []conformanceTest{
+		{input: []byte(" \u201D\u200D"), comment: "Pf QU ZWJ eot (LB15b)"},
+		{input: []byte("a \u201D\u0308x"), comment: "Pf QU CM AL (LB15b)"},
+		{input: []byte("\u4E2D\u201C\u0308\u4E2D"), comment: "ID QU CM ID (LB19a)"},
+		{input: []byte("\u4E2D\u201C\u0308"), comment: "ID QU CM eot (LB19a)"},
+		{input: []byte("\u094DaB/"), comment: "CM at sot (LB10)"},
+		{input: []byte("\u201D\u094D("), comment: "QU CM OP (LB19)"},
+	}
+	cases = append(cases, conformanceTests...)
+
+	mismatches := 0
+	for _, tc := range cases {
+		want, wantKinds, err := breaks(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, gotKinds := rulesetBreaks(tc.input, &options)
+		if fmt.Sprint(got, gotKinds) != fmt.Sprint(want, wantKinds) {
+			mismatches++
+			if mismatches <= 5 {
+				t.Errorf("line %d: input=%q got=%v %v want=%v %v %s", tc.lineNo, tc.input, got, gotKinds, want, wantKinds, tc.comment)
+			}
+		}
+	}
+	if mismatches > 0 {
+		t.Fatalf("ruleset mismatches: %d/%d", mismatches, len(cases))
+	}
+}
+
+func rulesetBreaks(input []byte, options *Options) ([]int, []breakKind) {
+	var offsets []int
+	var kinds []breakKind
+	for pos := 0; pos < len(input); {
+		advance, kind := nextBreak(input[pos:], options, nil)
+		pos += advance
+		offsets = append(offsets, pos)
+		kinds = append(kinds, kind)
+	}
+	return offsets, kinds
+}
+
+func TestRuleset_Tailoring(t *testing.T) {
+	tests := []struct {
+		name      string
+		tailoring string
+		input     string
+		want      []string
+	}{
+		{
+			name:  "default",
+			input: "usr/local ב/א",
+			want:  []string{"usr/", "local ", "ב/א"},
+		},
+		{
+			name:      "no break after slash",
+			tailoring: "LB28.5: SY × (AL | HL)",
+			input:     "usr/local ב/א",
+			want:      []string{"usr/local ", "ב/א"},
+		},
+		{
+			name:      "break before Hebrew after slash",
+			tailoring: "LB21a: SY ÷ HL",
+			input:     "usr/local ב/א",
+			want:      []string{"usr/", "local ", "ב/", "א"},
+		},
+		{
+			name:      "literal characters",
+			tailoring: "LB13.5: [—] ÷\nLB13.5: ÷ [—]",
+			input:     "a—b",
+			want:      []string{"a", "—", "b"},
+		},
+		{
+			name:      "mandatory break",
+			tailoring: "LB5.5: ! [§]",
+			input:     "a b§c",
+			want:      []string{"a ", "b", "§c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := ParseRuleset(DefaultRules + tt.tailoring)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := segments(tt.input, DefaultOptions.WithRuleset(rs))
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleset_ParseErrors(t *testing.T) {
+	tests := []string{
+		"AL NU",
+		"FOO × AL",
+		"× $Undefined",
+		"(AL | HL × NU",
+		"[AL × NU",
+		"× [\\p{Lu}]",
+		"LBx: AL × NU",
+		"AL × NU )",
+		"AL → [\\p{Pi}]",
+		"AL NU → BK",
+	}
+
+	for _, src := range tests {
+		if _, err := ParseRuleset(src); err == nil {
+			t.Errorf("ParseRuleset(%q) should fail", src)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package uax14
+
+import "unicode/utf8"
+
+// Separator is the kind of line terminator which caused a mandatory break.
+type Separator uint8
+
+const (
+	// NoSeparator is reported where a break is not mandatory, or is
+	// mandatory only because it is the end of text.
+	NoSeparator Separator = iota
+	// Newline is CR, LF, NEL (U+0085), or CR LF as one separator.
+	Newline
+	// LineSeparator is U+2028 LINE SEPARATOR or VT (U+000B), and any other
+	// character of class BK.
+	LineSeparator
+	// ParagraphSeparator is U+2029 PARAGRAPH SEPARATOR.
+	ParagraphSeparator
+	// PageBreak is FF (U+000C), a form feed.
+	PageBreak
+)
+
+// separatorOf returns the kind of separator at the end of segment, which
+// must end at a mandatory break.
+func separatorOf[T ~string | ~[]byte](segment T, options *Options) Separator {
+	r := decodeLastRune(segment)
+	if r == utf8.RuneError {
+		return NoSeparator
+	}
+	p, _ := lookupPropertyWith(segment[len(segment)-utf8.RuneLen(r):], options.overrides)
+
+	switch {
+	case p.is(_CR | _LF | _NL):
+		return Newline
+	case !p.is(_BK):
+		return NoSeparator
+	case r == '\f':
+		return PageBreak
+	case options.separatorsAsNewlines && (r == '\u2028' || r == '\u2029'):
+		return Newline
+	case r == '\u2029':
+		return ParagraphSeparator
+	}
+	return LineSeparator
+}
+
+// WithSeparatorsAsNewlines returns a copy of options which, if enabled,
+// reports U+2028 LINE SEPARATOR and U+2029 PARAGRAPH SEPARATOR as
+// [Newline], for callers which do not distinguish them. VT and FF are
+// unaffected.
+func (options Options) WithSeparatorsAsNewlines(enabled bool) Options {
+	options.separatorsAsNewlines = enabled
+	return options
+}
@@ -0,0 +1,79 @@
+package uax14
+
+import "testing"
+
+func TestIterator_Separator(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		options Options
+		want    []Separator
+	}{
+		{
+			name: "LF",
+			in:   "a\nb",
+			want: []Separator{Newline, NoSeparator},
+		},
+		{
+			name: "CRLF is one newline",
+			in:   "a\r\nb\r\n",
+			want: []Separator{Newline, Newline},
+		},
+		{
+			name: "CR and NEL",
+			in:   "a\rb\u0085c",
+			want: []Separator{Newline, Newline, NoSeparator},
+		},
+		{
+			name: "line and paragraph separators",
+			in:   "a\u2028b\u2029c",
+			want: []Separator{LineSeparator, ParagraphSeparator, NoSeparator},
+		},
+		{
+			name: "VT and FF",
+			in:   "a\vb\fc",
+			want: []Separator{LineSeparator, PageBreak, NoSeparator},
+		},
+		{
+			name: "opportunities",
+			in:   "a b\u2029",
+			want: []Separator{NoSeparator, ParagraphSeparator},
+		},
+		{
+			name:    "separators as newlines",
+			in:      "a\u2028b\u2029c\fd\ve",
+			options: DefaultOptions.WithSeparatorsAsNewlines(true),
+			want:    []Separator{Newline, Newline, PageBreak, LineSeparator, NoSeparator},
+		},
+		{
+			name:    "overridden class",
+			in:      "a\u2029b§c",
+			options: DefaultOptions.WithClassOverrides(map[rune]Class{'\u2029': AL, '§': BK}),
+			want:    []Separator{LineSeparator, NoSeparator},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{tt.options, tt.options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				iter := NewIterator(tt.in)
+				iter.SetOptions(options)
+				var got []Separator
+				for iter.Next() {
+					got = append(got, iter.Separator())
+					if iter.CanBreak() && iter.Separator() != NoSeparator {
+						t.Fatalf("segment %q: opportunity reports separator %d", iter.Current(), iter.Separator())
+					}
+				}
+				if len(got) != len(tt.want) {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+				for i := range got {
+					if got[i] != tt.want[i] {
+						t.Fatalf("got %v, want %v", got, tt.want)
+					}
+				}
+			})
+		}
+	}
+}
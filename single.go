@@ -0,0 +1,109 @@
+package uax14
+
+import (
+	"fmt"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Single-letter words, by language, which typographic convention keeps from
+// the end of a line: prepositions and conjunctions such as v, k, s, z and a.
+var singleLetterWordsByLanguage = map[string][]rune{
+	"cs": {'a', 'i', 'k', 'o', 's', 'u', 'v', 'z'},
+	"sk": {'a', 'i', 'k', 'o', 's', 'u', 'v', 'z'},
+	"pl": {'a', 'i', 'o', 'u', 'w', 'z'},
+	"sl": {'a', 'i', 'k', 'o', 's', 'u', 'v', 'z'},
+}
+
+// SingleLetterWordsForLanguage returns the single-letter words which the
+// language of a BCP 47 tag, such as "cs" or "pl-PL", keeps from the end of
+// a line, and whether the language has such a convention. Czech, Slovak,
+// Polish and Slovenian do. The result is a copy, which the caller may
+// modify.
+func SingleLetterWordsForLanguage(tag string) ([]rune, bool) {
+	letters, ok := singleLetterWordsForTag(parseLanguageTag(tag))
+	return slices.Clone(letters), ok
+}
+
+func singleLetterWordsForTag(lt languageTag) ([]rune, bool) {
+	letters, ok := singleLetterWordsByLanguage[lt.language]
+	return letters, ok
+}
+
+// WithSingleLetterWords returns a copy of options, which keeps the given
+// single-letter words from the end of a line, as is the convention in
+// Czech, Slovak, Polish and Slovenian: there is no break after the spaces
+// which follow a word of one of these letters alone, as if the spaces were
+// no-break spaces (LB18). Letters match in either case. A word stands alone
+// after a space, a line terminator, an opening bracket or quotation mark, or
+// at the start of the data. It replaces any earlier list; an empty list
+// restores the default.
+//
+// WithSingleLetterWords panics if a letter is not a valid Unicode scalar
+// value.
+func (options Options) WithSingleLetterWords(letters []rune) Options {
+	var words map[rune]bool
+	if len(letters) > 0 {
+		words = make(map[rune]bool, 2*len(letters))
+		for _, r := range letters {
+			if !utf8.ValidRune(r) {
+				panic(fmt.Sprintf("uax14: invalid rune %U in single-letter words", r))
+			}
+			words[unicode.ToLower(r)] = true
+			words[unicode.ToUpper(r)] = true
+		}
+	}
+	return options.tailor(func(t *tailoring) {
+		t.singleLetters = words
+	})
+}
+
+// afterSingleLetter reports whether pos, in data, follows spaces after a
+// single-letter word of ov, so that the break there (LB18) is suppressed.
+// The start of data is the start of a word, unless c says it is within one.
+func afterSingleLetter[T ~string | ~[]byte](data T, pos int, ov *overrides, c *constraints) bool {
+	if ov == nil || ov.singleLetters == nil {
+		return false
+	}
+
+	// Spaces
+	i := pos
+	for i > 0 {
+		r := decodeLastRune(data[:i])
+		w := utf8.RuneLen(r)
+		if r == utf8.RuneError {
+			return false
+		}
+		if p, _ := lookupPropertyWith(data[i-w:i], ov); !p.is(_SP) {
+			break
+		}
+		i -= w
+	}
+	if i == pos || i == 0 {
+		return false
+	}
+
+	// The letter
+	r := decodeLastRune(data[:i])
+	if !ov.singleLetters[r] {
+		return false
+	}
+	i -= utf8.RuneLen(r)
+	if i == 0 {
+		return c == nil || !c.midWord
+	}
+	return wordStartAt(data, i, ov)
+}
+
+// wordStartAt reports whether a word stands alone from the text before i,
+// in data, with i > 0: whether i follows a space, a line terminator, or an
+// opening bracket or quotation mark.
+func wordStartAt[T ~string | ~[]byte](data T, i int, ov *overrides) bool {
+	r := decodeLastRune(data[:i])
+	if r == utf8.RuneError {
+		return false
+	}
+	p, _ := lookupPropertyWith(data[i-utf8.RuneLen(r):i], ov)
+	return p.is(_SP|_BK|_CR|_LF|_NL|_ZW|_OP) || p.is(_QU) && !p.is(_PF) || unicode.IsSpace(r)
+}
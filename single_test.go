@@ -0,0 +1,137 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSingleLetterWords(t *testing.T) {
+	czech := DefaultOptions.WithSingleLetterWords([]rune{'a', 'i', 'k', 'o', 's', 'u', 'v', 'z'})
+
+	tests := []struct {
+		name    string
+		in      string
+		options Options
+		want    []string
+	}{
+		{
+			name:    "default",
+			in:      "Byl v Praze a Brně.",
+			options: DefaultOptions,
+			want:    []string{"Byl ", "v ", "Praze ", "a ", "Brně."},
+		},
+		{
+			name:    "single letters",
+			in:      "Byl v Praze a Brně.",
+			options: czech,
+			want:    []string{"Byl ", "v Praze ", "a Brně."},
+		},
+		{
+			name:    "consecutive",
+			in:      "šli a v noci",
+			options: czech,
+			want:    []string{"šli ", "a v noci"},
+		},
+		{
+			name:    "upper case",
+			in:      "V Praze",
+			options: czech,
+			want:    []string{"V Praze"},
+		},
+		{
+			name:    "several spaces",
+			in:      "k  domu",
+			options: czech,
+			want:    []string{"k  domu"},
+		},
+		{
+			name:    "after an opening bracket",
+			in:      "dům (v lese)",
+			options: czech,
+			want:    []string{"dům ", "(v lese)"},
+		},
+		{
+			name:    "after a line terminator",
+			in:      "dům\nv lese",
+			options: czech,
+			want:    []string{"dům\n", "v lese"},
+		},
+		{
+			name:    "not alone",
+			in:      "3v lese, bv lese",
+			options: czech,
+			want:    []string{"3v ", "lese, ", "bv ", "lese"},
+		},
+		{
+			name:    "after a hyphen",
+			in:      "a-v x",
+			options: czech,
+			want:    []string{"a-", "v ", "x"},
+		},
+		{
+			name:    "after an ideograph",
+			in:      "\u4E2Dv x",
+			options: czech,
+			want:    []string{"\u4E2D", "v ", "x"},
+		},
+		{
+			name:    "after a space, at the start of a segment",
+			in:      "a- v x",
+			options: czech,
+			want:    []string{"a- ", "v x"},
+		},
+		{
+			name:    "other letters",
+			in:      "x lese",
+			options: czech,
+			want:    []string{"x ", "lese"},
+		},
+		{
+			name:    "cleared",
+			in:      "v lese",
+			options: czech.WithSingleLetterWords(nil),
+			want:    []string{"v ", "lese"},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, options := range []Options{tt.options, tt.options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				got := segments(tt.in, options)
+				if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+					t.Fatalf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestSingleLetterWordsForLanguage(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+		ok   bool
+	}{
+		{"cs", "aikosuvz", true},
+		{"sk-SK", "aikosuvz", true},
+		{"pl", "aiouwz", true},
+		{"sl", "aikosuvz", true},
+		{"en", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := SingleLetterWordsForLanguage(tt.tag)
+			if string(got) != tt.want || ok != tt.ok {
+				t.Fatalf("got %q %v, want %q %v", string(got), ok, tt.want, tt.ok)
+			}
+		})
+	}
+
+	// The result does not share storage with the conventions
+	got, _ := SingleLetterWordsForLanguage("cs")
+	got[0] = 'x'
+	if again, _ := SingleLetterWordsForLanguage("cs"); again[0] == 'x' {
+		t.Errorf("modifying a result changed the convention: %q", string(again))
+	}
+}
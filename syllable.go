@@ -0,0 +1,99 @@
+package uax14
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// SyllableSegmenter is a [ComplexContextSegmenter] for Myanmar and Khmer,
+// which needs no dictionary. It finds the boundaries of orthographic
+// syllables, which are reasonable places to break a line in those scripts,
+// from character properties alone.
+//
+// A syllable begins at a consonant or independent vowel, unless it is
+// subscript, after a virama or coeng (U+1039, U+17D2), or it is a final
+// killed by an asat (U+103A). This is coarser than a dictionary: in Khmer,
+// where a final consonant takes no mark, it may separate the final
+// consonant from its syllable.
+type SyllableSegmenter struct {
+	// Other segments the parts of a run in other scripts, such as Thai, if
+	// not nil
+	Other ComplexContextSegmenter
+}
+
+// Segment implements [ComplexContextSegmenter].
+func (s SyllableSegmenter) Segment(run string) []int {
+	return s.segmentWith(run, nil)
+}
+
+// segmentWith is Segment, with the class overrides ov of the options in
+// use, so that a letter reassigned to CM does not begin a syllable.
+func (s SyllableSegmenter) segmentWith(run string, ov *overrides) []int {
+	var breaks []int
+	other := -1 // start of a part in another script, if any
+	var last rune
+	for i, r := range run {
+		inSyllabic := unicode.Is(syllabic, r)
+		if inSyllabic && other >= 0 {
+			breaks = s.other(breaks, run, other, i)
+			other = -1
+		}
+
+		switch {
+		case !inSyllabic:
+			if other < 0 {
+				other = i
+				if i > 0 {
+					breaks = append(breaks, i)
+				}
+			}
+		case i > 0 && isSyllableStart(run, i, r, last, ov):
+			breaks = append(breaks, i)
+		}
+		last = r
+	}
+	if other >= 0 {
+		breaks = s.other(breaks, run, other, len(run))
+	}
+	return breaks
+}
+
+// other appends the boundaries found by s.Other within run[start:end].
+func (s SyllableSegmenter) other(breaks []int, run string, start, end int) []int {
+	if s.Other == nil {
+		return breaks
+	}
+	for _, b := range s.Other.Segment(run[start:end]) {
+		if b > 0 && b < end-start {
+			breaks = append(breaks, start+b)
+		}
+	}
+	return breaks
+}
+
+const (
+	myanmarVirama = '\u1039'
+	myanmarAsat   = '\u103A'
+	myanmarDot    = '\u1037' // DOT BELOW, which precedes an asat
+	khmerCoeng    = '\u17D2'
+)
+
+// isSyllableStart reports whether r, at run[i] and after last, begins an
+// orthographic syllable.
+func isSyllableStart(run string, i int, r, last rune, ov *overrides) bool {
+	// Marks are resolved to CM, or reassigned to it
+	if p, _ := lookupPropertyWith(run[i:], ov); p.is(_CM|_ZWJ) || !unicode.Is(syllableBases, r) {
+		return false
+	}
+	if last == myanmarVirama || last == khmerCoeng {
+		return false
+	}
+
+	// A final consonant, killed by an asat
+	next := run[i+utf8.RuneLen(r):]
+	n, w := utf8.DecodeRuneInString(next)
+	if n == myanmarDot {
+		n, _ = utf8.DecodeRuneInString(next[w:])
+	}
+	return n != myanmarAsat
+}
@@ -0,0 +1,46 @@
+package uax14
+
+// Code generated by internal/gen; DO NOT EDIT.
+// Source: https://unicode.org/Public/17.0.0/ucd/LineBreak.txt
+// Source: https://unicode.org/Public/17.0.0/ucd/extracted/DerivedGeneralCategory.txt
+
+import "unicode"
+
+// syllabic are the characters of class SA in the Myanmar and Khmer blocks.
+var syllabic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1000, Hi: 0x103F, Stride: 1},
+		{Lo: 0x1050, Hi: 0x108F, Stride: 1},
+		{Lo: 0x109A, Hi: 0x109F, Stride: 1},
+		{Lo: 0x1780, Hi: 0x17D3, Stride: 1},
+		{Lo: 0x17D7, Hi: 0x17D7, Stride: 1},
+		{Lo: 0x17DC, Hi: 0x17DD, Stride: 1},
+		{Lo: 0xA9E0, Hi: 0xA9EF, Stride: 1},
+		{Lo: 0xA9FA, Hi: 0xA9FE, Stride: 1},
+		{Lo: 0xAA60, Hi: 0xAA7F, Stride: 1},
+	},
+}
+
+// syllableBases are the letters of syllabic, of General_Category Lo.
+var syllableBases = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1000, Hi: 0x102A, Stride: 1},
+		{Lo: 0x103F, Hi: 0x103F, Stride: 1},
+		{Lo: 0x1050, Hi: 0x1055, Stride: 1},
+		{Lo: 0x105A, Hi: 0x105D, Stride: 1},
+		{Lo: 0x1061, Hi: 0x1061, Stride: 1},
+		{Lo: 0x1065, Hi: 0x1066, Stride: 1},
+		{Lo: 0x106E, Hi: 0x1070, Stride: 1},
+		{Lo: 0x1075, Hi: 0x1081, Stride: 1},
+		{Lo: 0x108E, Hi: 0x108E, Stride: 1},
+		{Lo: 0x1780, Hi: 0x17B3, Stride: 1},
+		{Lo: 0x17DC, Hi: 0x17DC, Stride: 1},
+		{Lo: 0xA9E0, Hi: 0xA9E4, Stride: 1},
+		{Lo: 0xA9E7, Hi: 0xA9EF, Stride: 1},
+		{Lo: 0xA9FA, Hi: 0xA9FE, Stride: 1},
+		{Lo: 0xAA60, Hi: 0xAA6F, Stride: 1},
+		{Lo: 0xAA71, Hi: 0xAA76, Stride: 1},
+		{Lo: 0xAA7A, Hi: 0xAA7A, Stride: 1},
+		{Lo: 0xAA7E, Hi: 0xAA7F, Stride: 1},
+	},
+}
@@ -0,0 +1,87 @@
+package uax14
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestSyllableSegmenter(t *testing.T) {
+	tests := []struct {
+		run  string
+		want []string
+	}{
+		// Myanmar
+		{"မြန်မာ", []string{"မြန်", "မာ"}},
+		{"ကျွန်တော်", []string{"ကျွန်", "တော်"}},
+		{"မင်္ဂလာပါ", []string{"မင်္ဂ", "လာ", "ပါ"}}, // kinzi
+		{"သင့်", []string{"သင့်"}},                   // dot below before asat
+		{"ပုဂ္ဂိုလ်", []string{"ပု", "ဂ္ဂိုလ်"}},     // stacked consonants
+		{"အမေ", []string{"အ", "မေ"}},                 // independent vowel
+		// Khmer
+		{"ភាសាខ្មែរ", []string{"ភា", "សា", "ខ្មែ", "រ"}}, // final consonants are separated
+		{"កម្ពុជា", []string{"ក", "ម្ពុ", "ជា"}},
+		{"ថ្ងៃៗ", []string{"ថ្ងៃៗ"}}, // LEK TOO
+		// Other scripts are kept together
+		{"สวัสดีမြန်မာ", []string{"สวัสดี", "မြန်", "မာ"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := split(tt.run, SyllableSegmenter{}.Segment(tt.run)); strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("Segment(%q) = %q, want %q", tt.run, got, tt.want)
+		}
+	}
+}
+
+func TestSyllableSegmenter_Overrides(t *testing.T) {
+	// A letter reassigned to CM does not begin a syllable
+	options := DefaultOptions.WithClassOverrides(map[rune]Class{'\u1019': CM})
+	run := "\u1019\u103C\u1014\u103A\u1019\u102C"
+	want := []string{run}
+	if got := split(run, SyllableSegmenter{}.segmentWith(run, options.overrides)); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("segmentWith(%q) = %q, want %q", run, got, want)
+	}
+
+	// Syllable letters are from the generated tables, of the same Unicode
+	// version as the trie
+	for _, r := range []rune{'\u1000', '\u1780', '\uA9E0', '\uAA60'} {
+		if !unicode.Is(syllabic, r) {
+			t.Errorf("%U is not syllabic", r)
+		}
+	}
+}
+
+func TestSyllableSegmenter_Other(t *testing.T) {
+	d, err := NewDictionarySegmenter(strings.NewReader(thaiWords))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := SyllableSegmenter{Other: d}
+
+	run := "ฉันกินข้าวမြန်မာอร่อยมาก"
+	want := []string{"ฉัน", "กิน", "ข้าว", "မြန်", "မာ", "อร่อย", "มาก"}
+	if got := split(run, s.Segment(run)); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Segment(%q) = %q, want %q", run, got, want)
+	}
+
+	// As an option
+	options := DefaultOptions.WithComplexContextSegmenter(s)
+	want = []string{"(ฉัน", "กิน", "ข้าว", "မြန်", "မာ)"}
+	if got := segments("(ฉันกินข้าวမြန်မာ)", options); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// split divides s at the given byte offsets.
+func split(s string, offsets []int) []string {
+	var parts []string
+	last := 0
+	for _, o := range offsets {
+		parts = append(parts, s[last:o])
+		last = o
+	}
+	if last < len(s) {
+		parts = append(parts, s[last:])
+	}
+	return parts
+}
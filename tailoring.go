@@ -0,0 +1,139 @@
+package uax14
+
+// Strictness is the strictness of line breaking around Japanese and Chinese
+// punctuation and small kana, as in the CSS line-break property. These are
+// the rules known as kinsoku shori in Japanese typesetting.
+//
+// See https://www.w3.org/TR/css-text-3/#line-break-property.
+type Strictness uint8
+
+const (
+	// StrictnessStrict is the default algorithm of UAX #14. Small kana and
+	// the prolonged sound mark (class CJ) may not begin a line.
+	StrictnessStrict Strictness = iota
+	// StrictnessNormal allows small kana and the prolonged sound mark to
+	// begin a line (CJ as ID), and breaks before 〜 and ゠.
+	StrictnessNormal
+	// StrictnessLoose is StrictnessNormal, and also allows breaks before
+	// iteration marks such as 々 and ゝ, and before centered punctuation
+	// such as ・ ： ； ！ ？ and ‼.
+	StrictnessLoose
+)
+
+// WordBreak determines break opportunities within words, as in the CSS
+// word-break property.
+//
+// See https://www.w3.org/TR/css-text-3/#word-break-property.
+type WordBreak uint8
+
+const (
+	// WordBreakNormal is the default algorithm of UAX #14.
+	WordBreakNormal WordBreak = iota
+	// WordBreakBreakAll allows breaks within words: letters and digits
+	// (AL, HL and NU) act as ideographs (ID).
+	WordBreakBreakAll
+	// WordBreakKeepAll suppresses breaks between letters, digits and
+	// ideographs (AL, HL, NU, ID and Hangul), so that Chinese, Japanese and
+	// Korean text breaks only at spaces and punctuation.
+	WordBreakKeepAll
+	// WordBreakKorean breaks Korean text at word units, as in modern Korean
+	// typesetting: there are no breaks between Hangul (H2, H3, JL, JV and
+	// JT), or between Hangul and letters or digits (AL, HL and NU), so that
+	// text breaks at spaces and punctuation, as in Latin. Ideographs break
+	// according to [Options.WithIdeographs].
+	WordBreakKorean
+)
+
+// keepAllClasses are those between which WordBreakKeepAll suppresses breaks.
+const keepAllClasses = _AL | _HL | _NU | _ID | _H2 | _H3 | _JL | _JV | _JT
+
+// hangul are the classes of Hangul syllables and jamo.
+const hangul = _H2 | _H3 | _JL | _JV | _JT
+
+// koreanWordClasses are those which form words with Hangul, for
+// WordBreakKorean.
+const koreanWordClasses = hangul | _AL | _HL | _NU
+
+// Ideographs determines breaks around ideographs (class ID), such as Hanja,
+// in Korean text broken at word units; see [WordBreakKorean].
+type Ideographs uint8
+
+const (
+	// IdeographsBreak allows breaks before and after each ideograph, as in
+	// the default algorithm of UAX #14.
+	IdeographsBreak Ideographs = iota
+	// IdeographsAttach suppresses breaks between ideographs and Hangul, so
+	// that Hanja stay with the particles and endings which follow them, but
+	// allows breaks between ideographs, and between ideographs and letters.
+	IdeographsAttach
+	// IdeographsKeep suppresses breaks around ideographs, as around Hangul,
+	// so that they are part of words, as in [WordBreakKeepAll].
+	IdeographsKeep
+)
+
+// normalRunes are reassigned by StrictnessNormal, in addition to CJ.
+var normalRunes = map[rune]property{
+	'〜': _ID, // U+301C WAVE DASH
+	'゠': _ID, // U+30A0 KATAKANA-HIRAGANA DOUBLE HYPHEN
+}
+
+// looseRunes are reassigned by StrictnessLoose, in addition to CJ.
+var looseRunes = map[rune]property{
+	'〜': _ID, // U+301C WAVE DASH
+	'゠': _ID, // U+30A0 KATAKANA-HIRAGANA DOUBLE HYPHEN
+
+	// Iteration marks
+	'々': _ID, // U+3005
+	'〻': _ID, // U+303B
+	'ゝ': _ID, // U+309D
+	'ゞ': _ID, // U+309E
+	'ヽ': _ID, // U+30FD
+	'ヾ': _ID, // U+30FE
+
+	// Centered punctuation
+	'・': _ID, // U+30FB KATAKANA MIDDLE DOT
+	'：': _ID, // U+FF1A FULLWIDTH COLON
+	'；': _ID, // U+FF1B FULLWIDTH SEMICOLON
+	'･': _ID, // U+FF65 HALFWIDTH KATAKANA MIDDLE DOT
+	'‼': _ID, // U+203C
+	'⁇': _ID, // U+2047
+	'⁈': _ID, // U+2048
+	'⁉': _ID, // U+2049
+	'！': _ID, // U+FF01 FULLWIDTH EXCLAMATION MARK
+	'？': _ID, // U+FF1F FULLWIDTH QUESTION MARK
+}
+
+// WithStrictness returns a copy of options, with the given strictness.
+func (options Options) WithStrictness(strictness Strictness) Options {
+	return options.tailor(func(t *tailoring) {
+		t.strictness = strictness
+	})
+}
+
+// WithWordBreak returns a copy of options, with the given word breaking.
+func (options Options) WithWordBreak(wordBreak WordBreak) Options {
+	return options.tailor(func(t *tailoring) {
+		t.wordBreak = wordBreak
+	})
+}
+
+// WithIdeographs returns a copy of options, with the given breaking of
+// ideographs in Korean text; see [WordBreakKorean]. It has no effect with
+// other word breaking. The default is [IdeographsBreak].
+func (options Options) WithIdeographs(ideographs Ideographs) Options {
+	return options.tailor(func(t *tailoring) {
+		t.ideographs = ideographs
+	})
+}
+
+// WithAmbiguous returns a copy of options, with characters of ambiguous
+// class (AI) resolved to class, per LB1. The default is AL; ID is usual
+// for Chinese, Japanese and Korean text.
+//
+// WithAmbiguous panics if class is not exactly one line breaking class.
+func (options Options) WithAmbiguous(class Class) Options {
+	mustBeClass(class, "ambiguous class")
+	return options.tailor(func(t *tailoring) {
+		t.ambiguous = property(class)
+	})
+}
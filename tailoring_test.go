@@ -0,0 +1,77 @@
+package uax14
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTailoring(t *testing.T) {
+	korean := DefaultOptions.WithWordBreak(WordBreakKorean)
+
+	tests := []struct {
+		name    string
+		options Options
+		input   string
+		want    []string
+	}{
+		{"strict", DefaultOptions, "キャッシュ", []string{"キャッ", "シュ"}},
+		{"normal", DefaultOptions.WithStrictness(StrictnessNormal), "キャッシュ", []string{"キ", "ャ", "ッ", "シ", "ュ"}},
+		{"normal iteration mark", DefaultOptions.WithStrictness(StrictnessNormal), "人々", []string{"人々"}},
+		{"loose iteration mark", DefaultOptions.WithStrictness(StrictnessLoose), "人々", []string{"人", "々"}},
+		{"normal middle dot", DefaultOptions.WithStrictness(StrictnessNormal), "東京・大阪", []string{"東", "京・", "大", "阪"}},
+		{"loose middle dot", DefaultOptions.WithStrictness(StrictnessLoose), "東京・大阪", []string{"東", "京", "・", "大", "阪"}},
+		{"loose latin", DefaultOptions.WithStrictness(StrictnessLoose), "hello, world!", []string{"hello, ", "world!"}},
+
+		{"ambiguous as AL", DefaultOptions, "a①b", []string{"a①b"}},
+		{"ambiguous as ID", DefaultOptions.WithAmbiguous(ID), "a①b", []string{"a", "①", "b"}},
+		{"ambiguous as AL, explicitly", DefaultOptions.WithAmbiguous(ID).WithAmbiguous(AL), "a①b", []string{"a①b"}},
+
+		{"break-all", DefaultOptions.WithWordBreak(WordBreakBreakAll), "abc 12", []string{"a", "b", "c ", "1", "2"}},
+		{"break-all punctuation", DefaultOptions.WithWordBreak(WordBreakBreakAll), "ab.", []string{"a", "b."}},
+		{"keep-all", DefaultOptions.WithWordBreak(WordBreakKeepAll), "日本語の文章です。次", []string{"日本語の文章です。", "次"}},
+		{"keep-all Hangul", DefaultOptions.WithWordBreak(WordBreakKeepAll), "한국어 문장입니다", []string{"한국어 ", "문장입니다"}},
+		{"keep-all mixed", DefaultOptions.WithWordBreak(WordBreakKeepAll), "日本abc", []string{"日本abc"}},
+		{"keep-all then normal", DefaultOptions.WithWordBreak(WordBreakKeepAll).WithWordBreak(WordBreakNormal), "日本", []string{"日", "本"}},
+
+		{"Korean", korean, "한국어 문장입니다.", []string{"한국어 ", "문장입니다."}},
+		{"Korean with Latin and digits", korean, "iPhone을 샀다 (100원)", []string{"iPhone을 ", "샀다 ", "(100원)"}},
+		{"Korean jamo", korean, "\u1100\u1161\u1102\u1161 \u1103\u1161", []string{"\u1100\u1161\u1102\u1161 ", "\u1103\u1161"}},
+		{"Korean with ideographs", korean, "大韓民國의 首都는 서울이다", []string{"大", "韓", "民", "國", "의 ", "首", "都", "는 ", "서울이다"}},
+		{"Korean with ideographs attached", korean.WithIdeographs(IdeographsAttach), "大韓民國의 首都는 서울이다", []string{"大", "韓", "民", "國의 ", "首", "都는 ", "서울이다"}},
+		{"Korean with ideographs kept", korean.WithIdeographs(IdeographsKeep), "大韓民國의 首都는 서울이다", []string{"大韓民國의 ", "首都는 ", "서울이다"}},
+		{"Korean mixed", korean.WithIdeographs(IdeographsAttach), "Go언어는 2009년에 發表되었다.", []string{"Go언어는 ", "2009년에 ", "發", "表되었다."}},
+		{"Korean with Japanese", korean, "한국어와 日本語", []string{"한국어와 ", "日", "本", "語"}},
+		{"ideographs without Korean", DefaultOptions.WithIdeographs(IdeographsKeep), "國의", []string{"國", "의"}},
+
+		{
+			"class override wins",
+			DefaultOptions.WithStrictness(StrictnessLoose).WithClassOverrides(map[rune]Class{'々': NS}),
+			"人々",
+			[]string{"人々"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := segments(tt.input, tt.options)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			gotR := segments(tt.input, tt.options.WithRuleset(defaultRuleset))
+			if fmt.Sprint(gotR) != fmt.Sprint(tt.want) {
+				t.Fatalf("ruleset: got %q, want %q", gotR, tt.want)
+			}
+		})
+	}
+}
+
+func TestTailoring_NoOp(t *testing.T) {
+	options := DefaultOptions.
+		WithStrictness(StrictnessStrict).
+		WithWordBreak(WordBreakNormal).
+		WithAmbiguous(AL).
+		WithClassOverrides(map[rune]Class{'a': AL})
+	if options.overrides != nil {
+		t.Fatalf("tailorings with no effect should not compile a table, got %+v", options.overrides)
+	}
+}
@@ -0,0 +1,464 @@
+package uax14
+
+import "unicode"
+
+// Technical selects tailorings for technical text, such as URLs, in which
+// the default rules break in unhelpful places. See [Options.WithTechnical].
+type Technical uint8
+
+const (
+	// TechnicalURLs breaks URLs, such as https://example.com/a-b?c=d, as
+	// recommended by the Chicago Manual of Style: after a colon or //;
+	// before a single /, ~, ., comma, -, _, ?, # or %; before and after =
+	// and &; and nowhere else, so never after a hyphen.
+	TechnicalURLs Technical = 1 << iota
+	// TechnicalEmail breaks email addresses, such as jane.doe@example.com,
+	// before @ and before each period, and nowhere else.
+	TechnicalEmail
+	// TechnicalPaths breaks Unix and Windows file paths, such as
+	// /usr/local/bin, ~/go, ./a.out, C:\Users or \\server\share, before
+	// each separator, and nowhere else.
+	TechnicalPaths
+	// TechnicalIdentifiers breaks identifiers, such as
+	// net.http.ResponseWriter, MAX_RETRY_SECONDS or std::vector, at
+	// lowercase to uppercase transitions, after _, and after . or ::, and
+	// nowhere else. A word in camelCase alone, without _, a digit, . or ::,
+	// is an identifier only if each part is at least three characters, so
+	// that names such as iPhone and McDonald are not. These are low-priority
+	// opportunities; see [Iterator.LowPriority].
+	TechnicalIdentifiers
+)
+
+// WithTechnical returns a copy of options, which recognizes the given kinds
+// of technical text, and breaks within them by their own rules, in place of
+// the default rules. Elsewhere, the default rules apply. A zero t disables
+// them.
+//
+// Technical text is recognized in words delimited by spaces and line
+// terminators, less any surrounding punctuation, such as the parentheses of
+// (see https://example.com). A break is allowed before it, after a space;
+// otherwise, breaks before and after it are per the default rules.
+//
+// Technical tailorings apply to an [Iterator], and take precedence over the
+// rules, as do constraints; see [Iterator.SetConstraints].
+func (options Options) WithTechnical(t Technical) Options {
+	options.technical = t
+	return options
+}
+
+// technicalSpan is recognized technical text, from start up to end.
+type technicalSpan struct {
+	start, end int
+	// allowed are the positions of breaks within the span, in increasing
+	// order; there are no others
+	allowed []int
+	// afterSpace allows a break at start, after a space
+	afterSpace bool
+	// low are the breaks of identifiers, of low priority
+	low bool
+}
+
+// technicalCursor recognizes technical text, in a single forward pass over
+// data, as positions are queried.
+type technicalCursor[T ~string | ~[]byte] struct {
+	data  T
+	kinds Technical
+	// scanned is the end of the last word recognized
+	scanned int
+	// spans are recognized, in order, and end after the low water mark
+	spans []technicalSpan
+}
+
+// reset starts recognizing kinds of technical text in data.
+func (tc *technicalCursor[T]) reset(data T, kinds Technical) {
+	tc.data = data
+	tc.kinds = kinds
+	tc.scanned = 0
+	tc.spans = tc.spans[:0]
+}
+
+// forget discards spans which end at or before pos, which will not be
+// queried again.
+func (tc *technicalCursor[T]) forget(pos int) {
+	i := 0
+	for i < len(tc.spans) && tc.spans[i].end <= pos {
+		i++
+	}
+	tc.spans = append(tc.spans[:0], tc.spans[i:]...)
+}
+
+// at reports whether a break at pos is prohibited or allowed, within
+// technical text.
+func (tc *technicalCursor[T]) at(pos int) (held, allowed bool) {
+	for tc.scanned <= pos && tc.scanned < len(tc.data) {
+		tc.scan()
+	}
+	for i := range tc.spans {
+		span := &tc.spans[i]
+		if pos == span.start && span.afterSpace {
+			return false, true
+		}
+		if pos <= span.start {
+			break
+		}
+		if pos < span.end {
+			for _, a := range span.allowed {
+				if a == pos {
+					return false, true
+				}
+			}
+			return true, false
+		}
+	}
+	return false, false
+}
+
+// within reports whether pos is within a span, and whether a break there is
+// a low-priority one, within an identifier. Spans at pos must have been
+// scanned.
+func (tc *technicalCursor[T]) within(pos int) (within, low bool) {
+	for i := range tc.spans {
+		span := &tc.spans[i]
+		if pos > span.start && pos < span.end {
+			return true, span.low
+		}
+	}
+	return false, false
+}
+
+// scan recognizes the next word after tc.scanned.
+func (tc *technicalCursor[T]) scan() {
+	data := tc.data
+	// Skip spaces and line terminators
+	start := tc.scanned
+	for start < len(data) {
+		p, w := lookupProperty(data[start:])
+		if !p.is(_SP | _BK | _CR | _LF | _NL | _ZW) {
+			break
+		}
+		start += w
+	}
+	end := start
+	for end < len(data) {
+		p, w := lookupProperty(data[end:])
+		if p.is(_SP | _BK | _CR | _LF | _NL | _ZW) {
+			break
+		}
+		end += w
+	}
+	tc.scanned = end
+	if start == end {
+		return
+	}
+
+	afterSpace := false
+	if start > 0 {
+		p, _ := lookupProperty(data[start-1:])
+		afterSpace = p.is(_SP)
+	}
+	trimmed, end := trimPunctuation(data, start, end)
+	if end-trimmed < 2 {
+		return
+	}
+	afterSpace = afterSpace && trimmed == start
+	start = trimmed
+	word := data[start:end]
+	var allowed []int
+	low := false
+	switch {
+	case tc.kinds&TechnicalURLs != 0 && isURL(word):
+		allowed = urlBreaks(word)
+	case tc.kinds&TechnicalEmail != 0 && isEmail(word):
+		allowed = emailBreaks(word)
+	case tc.kinds&TechnicalPaths != 0 && isPath(word):
+		allowed = pathBreaks(word)
+	case tc.kinds&TechnicalIdentifiers != 0 && isIdentifier(word):
+		allowed = identifierBreaks(word)
+		if len(allowed) == 0 {
+			return
+		}
+		// Not after a space: an identifier is a word, as any other
+		afterSpace = false
+		low = true
+	default:
+		return
+	}
+	for i := range allowed {
+		allowed[i] += start
+	}
+	tc.spans = append(tc.spans, technicalSpan{start: start, end: end, allowed: allowed, afterSpace: afterSpace, low: low})
+}
+
+// trimPunctuation trims leading opening punctuation and quotation marks
+// from data[start:end], and trailing sentence punctuation, quotation marks,
+// and closing brackets without an opening one.
+func trimPunctuation[T ~string | ~[]byte](data T, start, end int) (int, int) {
+	for start < end && isByteOf(data[start], `([{<"'`) {
+		start++
+	}
+	for end > start {
+		c := data[end-1]
+		switch {
+		case isByteOf(c, `.,;:!?"'`):
+		case c == ')' && count(data[start:end], '(') < count(data[start:end], ')'):
+		case c == ']' && count(data[start:end], '[') < count(data[start:end], ']'):
+		case c == '}' && count(data[start:end], '{') < count(data[start:end], '}'):
+		case c == '>' && count(data[start:end], '<') < count(data[start:end], '>'):
+		default:
+			return start, end
+		}
+		end--
+	}
+	return start, end
+}
+
+func isByteOf(c byte, set string) bool {
+	for i := 0; i < len(set); i++ {
+		if set[i] == c {
+			return true
+		}
+	}
+	return false
+}
+
+func count[T ~string | ~[]byte](data T, c byte) int {
+	n := 0
+	for i := 0; i < len(data); i++ {
+		if data[i] == c {
+			n++
+		}
+	}
+	return n
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isURL reports whether word begins with a scheme and //, such as https://,
+// or with www.
+func isURL[T ~string | ~[]byte](word T) bool {
+	if len(word) > 4 && string(word[:4]) == "www." {
+		return true
+	}
+	if len(word) == 0 || !isASCIILetter(word[0]) {
+		return false
+	}
+	for i := 1; i < len(word); i++ {
+		c := word[i]
+		switch {
+		case isASCIILetter(c) || isASCIIDigit(c) || c == '+' || c == '-' || c == '.':
+		case c == ':':
+			return i+3 < len(word) && word[i+1] == '/' && word[i+2] == '/'
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// urlBreaks returns the breaks within a URL.
+func urlBreaks[T ~string | ~[]byte](word T) []int {
+	var allowed []int
+	for i := 1; i < len(word); i++ {
+		c, prev := word[i], word[i-1]
+		var next byte
+		if i+1 < len(word) {
+			next = word[i+1]
+		}
+		switch {
+		case prev == '/' && i >= 2 && word[i-2] == '/' && c != '/':
+			// After //
+		case prev == ':' && c != '/':
+			// After a colon
+		case c == '/' && prev != '/' && next != '/':
+			// Before a single /
+		case isByteOf(c, "~.,-_?#%") && prev != c:
+			// Before ~ . , - _ ? # or %
+		case c == '=' || c == '&' || prev == '=' || prev == '&':
+			// Before and after = and &
+		default:
+			continue
+		}
+		allowed = append(allowed, i)
+	}
+	return allowed
+}
+
+// isEmail reports whether word is of the form local@domain.tld.
+func isEmail[T ~string | ~[]byte](word T) bool {
+	at := -1
+	for i := 0; i < len(word); i++ {
+		switch c := word[i]; {
+		case c == '@':
+			if at >= 0 {
+				return false
+			}
+			at = i
+		case c == '/' || c == '\\' || c == ':':
+			return false
+		}
+	}
+	if at <= 0 || at == len(word)-1 {
+		return false
+	}
+	domain := word[at+1:]
+	dot := -1
+	for i := 0; i < len(domain); i++ {
+		if domain[i] == '.' {
+			dot = i
+		}
+	}
+	return dot > 0 && dot < len(domain)-1
+}
+
+// emailBreaks returns the breaks within an email address.
+func emailBreaks[T ~string | ~[]byte](word T) []int {
+	var allowed []int
+	for i := 1; i < len(word); i++ {
+		if (word[i] == '@' || word[i] == '.') && word[i-1] != '.' {
+			allowed = append(allowed, i)
+		}
+	}
+	return allowed
+}
+
+// isPath reports whether word begins as a Unix or Windows file path, such
+// as /, ./, ../, ~/, C:\ or \\.
+func isPath[T ~string | ~[]byte](word T) bool {
+	s := string(word[:min(len(word), 3)])
+	switch {
+	case s[0] == '/' && len(s) > 1 && s[1] != '/':
+		return true
+	case len(s) >= 2 && (s[:2] == "./" || s[:2] == "~/" || s[:2] == `\\` || s[:2] == `.\`):
+		return true
+	case len(s) == 3 && (s == "../" || s == `..\`):
+		return true
+	case len(s) == 3 && isASCIILetter(s[0]) && s[1] == ':' && (s[2] == '\\' || s[2] == '/'):
+		return true
+	}
+	return false
+}
+
+// pathBreaks returns the breaks within a file path.
+func pathBreaks[T ~string | ~[]byte](word T) []int {
+	var allowed []int
+	for i := 1; i < len(word); i++ {
+		c, prev := word[i], word[i-1]
+		if (c == '/' || c == '\\') && prev != '/' && prev != '\\' && prev != ':' {
+			allowed = append(allowed, i)
+		}
+	}
+	return allowed
+}
+
+func isIdentifierRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+}
+
+// minCamelCasePart is the length, in characters, of the shortest part of
+// an identifier in camelCase without an identifier marker: _, a digit, or
+// . or ::.
+const minCamelCasePart = 3
+
+// isIdentifier reports whether word is an identifier, or identifiers
+// separated by . or ::, such as net.http.Header or std::vector. Each must be
+// at least two characters, and not begin with a digit, so that e.g. and
+// 3.14 are not identifiers. In a word without _, a digit, . or ::, each part
+// in camelCase must be at least minCamelCasePart characters, so that names
+// such as iPhone and McDonald are not identifiers.
+func isIdentifier[T ~string | ~[]byte](word T) bool {
+	n := 0 // characters in the current identifier
+	marked := false
+	for i := 0; ; {
+		if i < len(word) {
+			r, w := decodeRuneWidth(word[i:])
+			if isIdentifierRune(r) {
+				if n == 0 && unicode.IsDigit(r) {
+					return false
+				}
+				marked = marked || r == '_' || unicode.IsDigit(r)
+				n++
+				i += w
+				continue
+			}
+		}
+		if n < 2 {
+			return false
+		}
+		switch {
+		case i == len(word):
+			return marked || longCamelCaseParts(word)
+		case word[i] == '.':
+			i++
+		case word[i] == ':' && i+1 < len(word) && word[i+1] == ':':
+			i += 2
+		default:
+			return false
+		}
+		marked = true
+		n = 0
+	}
+}
+
+// longCamelCaseParts reports whether each part of word, between its breaks
+// as an identifier, is at least minCamelCasePart characters.
+func longCamelCaseParts[T ~string | ~[]byte](word T) bool {
+	start := 0
+	for _, end := range append(identifierBreaks(word), len(word)) {
+		n := 0
+		for i := start; i < end; n++ {
+			_, w := decodeRuneWidth(word[i:])
+			i += w
+		}
+		if n < minCamelCasePart {
+			return false
+		}
+		start = end
+	}
+	return true
+}
+
+// identifierBreaks returns the breaks within an identifier.
+func identifierBreaks[T ~string | ~[]byte](word T) []int {
+	var allowed []int
+	letters := false // seen a letter or digit in the current identifier
+	prev, i := decodeRuneWidth(word)
+	for i < len(word) {
+		c, w := decodeRuneWidth(word[i:])
+		if prev == '.' || prev == ':' {
+			letters = false
+		} else if prev != '_' {
+			letters = true
+		}
+
+		switch {
+		case prev == '.' || prev == ':' && c != ':':
+			// After . or ::
+		case prev == '_' && c != '_' && letters:
+			// After _, but not leading ones, as in __init__
+		case isUpper(c) && isLowerOrDigit(prev):
+			// camelCase
+		case isUpper(c) && isUpper(prev) && i+w < len(word) && isLowerOrDigit(decodeRune(word[i+w:])):
+			// The end of an acronym, as in HTTPServer
+		default:
+			prev, i = c, i+w
+			continue
+		}
+		allowed = append(allowed, i)
+		prev, i = c, i+w
+	}
+	return allowed
+}
+
+func isUpper(r rune) bool {
+	return unicode.IsUpper(r)
+}
+
+func isLowerOrDigit(r rune) bool {
+	return unicode.IsLower(r) || unicode.IsDigit(r)
+}
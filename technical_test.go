@@ -0,0 +1,202 @@
+package uax14
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestOptions_WithTechnical(t *testing.T) {
+	all := TechnicalURLs | TechnicalEmail | TechnicalPaths
+
+	tests := []struct {
+		name      string
+		in        string
+		technical Technical
+		want      []string
+	}{
+		{
+			name:      "default",
+			in:        "https://example.com/a-b/c",
+			technical: 0,
+			want:      []string{"https://", "example.com/", "a-", "b/", "c"},
+		},
+		{
+			name:      "URL",
+			in:        "https://example.com/a-b/c",
+			technical: all,
+			want:      []string{"https://", "example", ".com", "/a", "-b", "/c"},
+		},
+		{
+			name:      "URL query",
+			in:        "www.example.com?q=line+breaking&lang=en",
+			technical: all,
+			want:      []string{"www", ".example", ".com", "?q", "=", "line+breaking", "&", "lang", "=", "en"},
+		},
+		{
+			name:      "URL in a sentence",
+			in:        "See (https://go.dev/doc). Thanks",
+			technical: all,
+			want:      []string{"See ", "(https://", "go", ".dev", "/doc). ", "Thanks"},
+		},
+		{
+			name:      "URLs only",
+			in:        "jane.doe@example.com https://go.dev",
+			technical: TechnicalURLs,
+			want:      []string{"jane.doe@example.com ", "https://", "go", ".dev"},
+		},
+		{
+			name:      "email",
+			in:        "Mail jane.doe@example.com, today",
+			technical: all,
+			want:      []string{"Mail ", "jane", ".doe", "@example", ".com, ", "today"},
+		},
+		{
+			name:      "Unix path",
+			in:        "cd /usr/local-go/bin",
+			technical: all,
+			want:      []string{"cd ", "/usr", "/local-go", "/bin"},
+		},
+		{
+			name:      "relative path",
+			in:        "../a-b/c.txt",
+			technical: all,
+			want:      []string{"..", "/a-b", "/c.txt"},
+		},
+		{
+			name:      "Windows path",
+			in:        `C:\Program Files\Go`,
+			technical: all,
+			want:      []string{`C:\Program `, `Files\Go`},
+		},
+		{
+			name:      "UNC path",
+			in:        `\\server\share\file-name`,
+			technical: all,
+			want:      []string{`\\server`, `\share`, `\file-name`},
+		},
+		{
+			name:      "not technical",
+			in:        "and/or a-b x.y",
+			technical: all,
+			want:      []string{"and/", "or ", "a-", "b ", "x.y"},
+		},
+	}
+
+	for _, tt := range tests {
+		options := DefaultOptions.WithTechnical(tt.technical)
+		for _, options := range []Options{options, options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				got := segments(tt.in, options)
+				if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+					t.Fatalf("got %q, want %q", got, tt.want)
+				}
+				bytes := segments([]byte(tt.in), options)
+				if len(bytes) != len(got) {
+					t.Fatalf("bytes: got %q, want %q", bytes, got)
+				}
+			})
+		}
+	}
+}
+
+func TestOptions_WithTechnicalConstraints(t *testing.T) {
+	in := "https://example.com/a/b"
+	iter := NewIterator(in)
+	iter.SetOptions(DefaultOptions.WithTechnical(TechnicalURLs))
+	// The caller's constraints take precedence
+	if err := iter.SetConstraints([]Span{{0, 19}}, []int{21}); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for iter.Next() {
+		got = append(got, iter.Current())
+	}
+	want := []string{"https://example.com", "/a", "/b"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestOptions_WithTechnicalIdentifiers(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+		low  []bool
+	}{
+		{
+			name: "dotted camelCase",
+			in:   "net.http.ResponseWriterWrapperFactory",
+			want: []string{"net.", "http.", "Response", "Writer", "Wrapper", "Factory"},
+			low:  []bool{true, true, true, true, true, false},
+		},
+		{
+			name: "snake case",
+			in:   "set MAX_RETRY_BACKOFF_SECONDS now",
+			want: []string{"set ", "MAX_", "RETRY_", "BACKOFF_", "SECONDS ", "now"},
+			low:  []bool{false, true, true, true, false, false},
+		},
+		{
+			name: "leading underscores",
+			in:   "__init__",
+			want: []string{"__init__"},
+			low:  []bool{false},
+		},
+		{
+			name: "scope",
+			in:   "std::vector",
+			want: []string{"std::", "vector"},
+			low:  []bool{true, false},
+		},
+		{
+			name: "acronyms",
+			in:   "(HTTPServer)",
+			want: []string{"(HTTP", "Server)"},
+			low:  []bool{true, false},
+		},
+		{
+			name: "names",
+			in:   "iPhone McDonald eBay",
+			want: []string{"iPhone ", "McDonald ", "eBay"},
+			low:  []bool{false, false, false},
+		},
+		{
+			name: "marked names",
+			in:   "iPhone_15 Mc.Donald",
+			want: []string{"i", "Phone_", "15 ", "Mc.", "Donald"},
+			low:  []bool{true, true, false, true, false},
+		},
+		{
+			name: "non-ASCII letters",
+			in:   "größeÄndern straße.Übersicht",
+			want: []string{"größe", "Ändern ", "straße.", "Übersicht"},
+			low:  []bool{true, false, true, false},
+		},
+		{
+			name: "not identifiers",
+			in:   "e.g. 3.14 v1.2 hello",
+			want: []string{"e.g. ", "3.14 ", "v1.2 ", "hello"},
+			low:  []bool{false, false, false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		options := DefaultOptions.WithTechnical(TechnicalIdentifiers)
+		for _, options := range []Options{options, options.WithRuleset(defaultRuleset)} {
+			t.Run(tt.name, func(t *testing.T) {
+				iter := NewIterator(tt.in)
+				iter.SetOptions(options)
+				var got []string
+				var low []bool
+				for iter.Next() {
+					got = append(got, iter.Current())
+					low = append(low, iter.LowPriority())
+				}
+				if strings.Join(got, "|") != strings.Join(tt.want, "|") || fmt.Sprint(low) != fmt.Sprint(tt.low) {
+					t.Fatalf("got %q %v, want %q %v", got, low, tt.want, tt.low)
+				}
+			})
+		}
+	}
+}
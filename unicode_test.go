@@ -5,6 +5,20 @@ import (
 	"testing"
 )
 
+// conformanceTest is a case of a Unicode conformance test file, generated
+// by internal/gen.
+type conformanceTest struct {
+	lineNo       int
+	input        []byte
+	breakOffsets []int
+	comment      string
+}
+
+// conformanceTests are the cases of LineBreakTest.txt. They are set by
+// unicode_conformance_test.go, which is generated by go generate, and is
+// too large to commit.
+var conformanceTests []conformanceTest
+
 func TestLineBreakConformance(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping conformance test in short mode")
@@ -12,7 +26,7 @@ func TestLineBreakConformance(t *testing.T) {
 
 	cases := len(conformanceTests)
 	if cases == 0 {
-		t.Fatal("no generated conformance test cases")
+		t.Fatal("no generated conformance test cases; run go generate")
 	}
 
 	mismatches := 0
@@ -113,3 +127,32 @@ func breaks(input []byte) ([]int, []breakKind, error) {
 
 	return offsets, kinds, nil
 }
+
+// TestNextBreak_CombiningMarks checks that CM and ZWJ are treated as the
+// character they follow (LB9), or as AL at the start of text (LB10), by the
+// rules which look back past them.
+func TestNextBreak_CombiningMarks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []int
+	}{
+		{"sot CM as AL", "\u094DaB/", []int{6}},
+		{"QU CM", "\u201D\u094D(", []int{7}},
+		{"sot HY CM", "-\u0308a", []int{4}},
+		{"HL HY CM", "\u05D0-\u0308a", []int{6}},
+		{"AK VI CM AK", "\u1B05\u1B44\u0308\u1B05", []int{11}},
+		{"after BK", "\v\u0308\u2757", []int{1, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := breaks([]byte(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("NextBreak(%q) breaks at %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
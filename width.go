@@ -0,0 +1,131 @@
+package uax14
+
+import "unicode/utf8"
+
+func (p widthProperty) is(properties widthProperty) bool {
+	return (p & properties) != 0
+}
+
+const (
+	// vs15 requests text presentation of the preceding emoji
+	vs15 = '\uFE0E'
+	// vs16 requests emoji presentation of the preceding character
+	vs16 = '\uFE0F'
+)
+
+// Widths measures the display width of text in terminal columns, from the
+// same Unicode version as the line break properties, so that wrapping by
+// width and breaking agree.
+//
+// Each extended grapheme cluster is as wide as its first character which
+// is not zero width: two columns where East_Asian_Width is Wide or
+// Fullwidth, or Emoji_Presentation is Yes, and one otherwise. Nonspacing
+// and enclosing marks, format characters, such as ZWJ, and Hangul medial
+// vowels and final consonants are zero width, as are controls. U+00AD SOFT
+// HYPHEN, though a format character, is one column, as by wcwidth, since
+// terminals display it. So an
+// emoji ZWJ sequence is as wide as the emoji it begins with, and a Hangul
+// syllable of conjoining jamo is two columns. VS16 after an emoji makes it
+// two columns, and VS15 after an emoji of emoji presentation, one.
+//
+// Characters whose East_Asian_Width is Ambiguous, such as some Greek and
+// Cyrillic letters and box drawing, are one column, unless set otherwise
+// by [Widths.WithAmbiguousWidth].
+//
+// Widths is a value; each With method returns a copy. The zero value is
+// [DefaultWidths].
+type Widths struct {
+	// ambiguousWide makes Ambiguous characters two columns
+	ambiguousWide bool
+}
+
+// DefaultWidths measures Ambiguous characters as one column, as in most
+// terminals outside of East Asian locales.
+var DefaultWidths = Widths{}
+
+// WithAmbiguousWidth returns a copy of w, which measures characters whose
+// East_Asian_Width is Ambiguous as width columns, 1 or 2, as in East Asian
+// locales. Other widths are treated as the nearest of those.
+func (w Widths) WithAmbiguousWidth(width int) Widths {
+	w.ambiguousWide = width >= 2
+	return w
+}
+
+// Width returns the display width of s in terminal columns, as by
+// [DefaultWidths]. See [Widths.Width] and [Widths.WidthBytes] for other
+// widths.
+func Width[T ~string | ~[]byte](s T) int {
+	return width(s, DefaultWidths)
+}
+
+// RuneWidth returns the display width of r in terminal columns, as by
+// [DefaultWidths], where r stands alone.
+func RuneWidth(r rune) int {
+	return DefaultWidths.RuneWidth(r)
+}
+
+// Width returns the display width of s in terminal columns.
+func (w Widths) Width(s string) int {
+	return width(s, w)
+}
+
+// WidthBytes returns the display width of b in terminal columns.
+func (w Widths) WidthBytes(b []byte) int {
+	return width(b, w)
+}
+
+// RuneWidth returns the display width of r in terminal columns, where r
+// stands alone.
+func (w Widths) RuneWidth(r rune) int {
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	return clusterWidth(buf[:n], w)
+}
+
+// width returns the width of data, the sum of those of its grapheme
+// clusters.
+func width[T ~string | ~[]byte](data T, w Widths) int {
+	n := 0
+	for len(data) > 0 {
+		g := nextGrapheme(data)
+		n += clusterWidth(data[:g], w)
+		data = data[g:]
+	}
+	return n
+}
+
+// clusterWidth returns the width of an extended grapheme cluster.
+func clusterWidth[T ~string | ~[]byte](cluster T, w Widths) int {
+	for pos := 0; pos < len(cluster); {
+		p, sz := lookupWidth(cluster[pos:])
+		if sz == 0 {
+			// Incomplete UTF-8, as the replacement character
+			return 1
+		}
+		pos += sz
+		switch {
+		case p.is(_wControl):
+			return 0
+		case p.is(_wZero):
+			continue
+		}
+
+		// The base of the cluster
+		next := rune(-1)
+		if pos < len(cluster) {
+			next = decodeRune(cluster[pos:])
+		}
+		switch {
+		case next == vs15 && p.is(_wEmojiPresentation):
+			return 1
+		case next == vs16 && p.is(_wEmoji):
+			return 2
+		case p.is(_wWide | _wEmojiPresentation):
+			return 2
+		case p.is(_wAmbiguous) && w.ambiguousWide:
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
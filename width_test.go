@@ -0,0 +1,87 @@
+package uax14
+
+import "testing"
+
+func TestRuneWidth(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want int
+	}{
+		{'a', 1},
+		{'\t', 0},
+		{'\u0301', 0},
+		{'\u200D', 0},
+		{'中', 2},
+		{'한', 2},
+		{'ｱ', 1}, // halfwidth katakana
+		{'Ａ', 2}, // fullwidth A
+		{'\U0001F600', 2},
+		{'é', 1},
+		{'\u1161', 0}, // Hangul medial vowel
+		{'±', 1},      // ambiguous
+		{'❤', 1},      // emoji of text presentation
+		{'\U0001F1FA', 2},
+		{'\u00AD', 1}, // soft hyphen, as by wcwidth
+	}
+	for _, tt := range tests {
+		if got := RuneWidth(tt.r); got != tt.want {
+			t.Errorf("RuneWidth(%U) = %d, want %d", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ascii", "hello", 5},
+		{"wide", "日本語", 6},
+		{"combining", "e\u0301", 1},
+		{"conjoining jamo", "\u1112\u1161\u11AB", 2},
+		{"ZWJ sequence", "\U0001F468\u200D\U0001F4BB", 2},
+		{"flag", "\U0001F1FA\U0001F1F8", 2},
+		{"VS16", "❤\uFE0F", 2},
+		{"VS15", "\U0001F600\uFE0E", 1},
+		{"keycap", "1\uFE0F\u20E3", 2},
+		{"VS16 after text", "a\uFE0F", 1},
+		{"controls", "a\r\nb\t", 2},
+		{"invalid", "a\xffb", 3},
+		{"incomplete", "\xe4\xb8", 1},
+		{"soft hyphen", "co\u00ADop", 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Width(tt.in); got != tt.want {
+				t.Errorf("Width(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+			if got := Width([]byte(tt.in)); got != tt.want {
+				t.Errorf("Width([]byte(%q)) = %d, want %d", tt.in, got, tt.want)
+			}
+			if got := DefaultWidths.Width(tt.in); got != tt.want {
+				t.Errorf("DefaultWidths.Width(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+			if got := DefaultWidths.WidthBytes([]byte(tt.in)); got != tt.want {
+				t.Errorf("DefaultWidths.WidthBytes(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWidths_WithAmbiguousWidth(t *testing.T) {
+	wide := DefaultWidths.WithAmbiguousWidth(2)
+	if got := wide.Width("±─a"); got != 5 {
+		t.Errorf("got %d, want 5", got)
+	}
+	if got := wide.RuneWidth('\u0391'); got != 2 {
+		t.Errorf("got %d, want 2", got)
+	}
+	if got := DefaultWidths.Width("±─a"); got != 3 {
+		t.Errorf("got %d, want 3", got)
+	}
+	if got := wide.WithAmbiguousWidth(1).RuneWidth('\u0391'); got != 1 {
+		t.Errorf("got %d, want 1", got)
+	}
+}
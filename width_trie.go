@@ -0,0 +1,1863 @@
+package uax14
+
+// Code generated by internal/gen; DO NOT EDIT.
+// Source: https://unicode.org/Public/17.0.0/ucd/EastAsianWidth.txt
+// Source: https://unicode.org/Public/17.0.0/ucd/extracted/DerivedGeneralCategory.txt
+// Source: https://unicode.org/Public/17.0.0/ucd/emoji/emoji-data.txt
+// Source: https://unicode.org/Public/17.0.0/ucd/auxiliary/GraphemeBreakProperty.txt
+
+// widthProperty is a set of properties for display width in terminal columns.
+type widthProperty uint32
+
+const (
+	_wAmbiguous widthProperty = 1 << iota
+	_wControl
+	_wEmoji
+	_wEmojiPresentation
+	_wWide
+	_wZero
+)
+
+// lookupWidth returns the trie value for the first UTF-8 encoding in s and
+// the width in bytes of this encoding. The size will be 0 if s does not
+// hold enough bytes to complete the encoding. len(s) must be greater than 0.
+func lookupWidth[T ~string | ~[]byte](s T) (v widthProperty, sz int) {
+	c0 := s[0]
+	switch {
+	case c0 < 0x80: // is ASCII
+		return widthValues[c0], 1
+	case c0 < 0xC2:
+		return 0, 1 // Illegal UTF-8: not a starter, not ASCII.
+	case c0 < 0xE0: // 2-byte UTF-8
+		if len(s) < 2 {
+			return 0, 0
+		}
+		i := widthIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		return lookupWidthValue(uint32(i), c1), 2
+	case c0 < 0xF0: // 3-byte UTF-8
+		if len(s) < 3 {
+			return 0, 0
+		}
+		i := widthIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		o := uint32(i)<<6 + uint32(c1)
+		i = widthIndex[o]
+		c2 := s[2]
+		if c2 < 0x80 || 0xC0 <= c2 {
+			return 0, 2 // Illegal UTF-8: not a continuation byte.
+		}
+		return lookupWidthValue(uint32(i), c2), 3
+	case c0 < 0xF8: // 4-byte UTF-8
+		if len(s) < 4 {
+			return 0, 0
+		}
+		i := widthIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		o := uint32(i)<<6 + uint32(c1)
+		i = widthIndex[o]
+		c2 := s[2]
+		if c2 < 0x80 || 0xC0 <= c2 {
+			return 0, 2 // Illegal UTF-8: not a continuation byte.
+		}
+		o = uint32(i)<<6 + uint32(c2)
+		i = widthIndex[o]
+		c3 := s[3]
+		if c3 < 0x80 || 0xC0 <= c3 {
+			return 0, 3 // Illegal UTF-8: not a continuation byte.
+		}
+		return lookupWidthValue(uint32(i), c3), 4
+	}
+	// Illegal rune
+	return 0, 1
+}
+
+// widthTrie. Total size: 20416 bytes (19.94 KiB). Checksum: 4a73d2807c80c4d.
+// type widthTrie struct { }
+
+// func newWidthTrie(i int) *widthTrie {
+// 	return &widthTrie{}
+// }
+
+// lookupWidthValue determines the type of block n and looks up the value for b.
+func lookupWidthValue(n uint32, b byte) widthProperty {
+	switch {
+	default:
+		return widthProperty(widthValues[n<<6+uint32(b)])
+	}
+}
+
+// widthValues: 259 blocks, 16576 entries, 16576 bytes
+// The third block is the zero block.
+var widthValues = [16576]widthProperty{
+	// Block 0x0, offset 0x0
+	0x00: 0x0002, 0x01: 0x0002, 0x02: 0x0002, 0x03: 0x0002, 0x04: 0x0002, 0x05: 0x0002,
+	0x06: 0x0002, 0x07: 0x0002, 0x08: 0x0002, 0x09: 0x0002, 0x0a: 0x0002, 0x0b: 0x0002,
+	0x0c: 0x0002, 0x0d: 0x0002, 0x0e: 0x0002, 0x0f: 0x0002, 0x10: 0x0002, 0x11: 0x0002,
+	0x12: 0x0002, 0x13: 0x0002, 0x14: 0x0002, 0x15: 0x0002, 0x16: 0x0002, 0x17: 0x0002,
+	0x18: 0x0002, 0x19: 0x0002, 0x1a: 0x0002, 0x1b: 0x0002, 0x1c: 0x0002, 0x1d: 0x0002,
+	0x1e: 0x0002, 0x1f: 0x0002, 0x23: 0x0004,
+	0x2a: 0x0004,
+	0x30: 0x0004, 0x31: 0x0004, 0x32: 0x0004, 0x33: 0x0004, 0x34: 0x0004, 0x35: 0x0004,
+	0x36: 0x0004, 0x37: 0x0004, 0x38: 0x0004, 0x39: 0x0004,
+	// Block 0x1, offset 0x40
+	0x7f: 0x0002,
+	// Block 0x2, offset 0x80
+	// Block 0x3, offset 0xc0
+	0xc0: 0x0002, 0xc1: 0x0002, 0xc2: 0x0002, 0xc3: 0x0002, 0xc4: 0x0002, 0xc5: 0x0002,
+	0xc6: 0x0002, 0xc7: 0x0002, 0xc8: 0x0002, 0xc9: 0x0002, 0xca: 0x0002, 0xcb: 0x0002,
+	0xcc: 0x0002, 0xcd: 0x0002, 0xce: 0x0002, 0xcf: 0x0002, 0xd0: 0x0002, 0xd1: 0x0002,
+	0xd2: 0x0002, 0xd3: 0x0002, 0xd4: 0x0002, 0xd5: 0x0002, 0xd6: 0x0002, 0xd7: 0x0002,
+	0xd8: 0x0002, 0xd9: 0x0002, 0xda: 0x0002, 0xdb: 0x0002, 0xdc: 0x0002, 0xdd: 0x0002,
+	0xde: 0x0002, 0xdf: 0x0002, 0xe1: 0x0001,
+	0xe4: 0x0001, 0xe7: 0x0001, 0xe8: 0x0001, 0xe9: 0x0004,
+	0xea: 0x0001, 0xed: 0x0001, 0xee: 0x0005,
+	0xf0: 0x0001, 0xf1: 0x0001, 0xf2: 0x0001, 0xf3: 0x0001, 0xf4: 0x0001,
+	0xf6: 0x0001, 0xf7: 0x0001, 0xf8: 0x0001, 0xf9: 0x0001, 0xfa: 0x0001,
+	0xfc: 0x0001, 0xfd: 0x0001, 0xfe: 0x0001, 0xff: 0x0001,
+	// Block 0x4, offset 0x100
+	0x106: 0x0001,
+	0x110: 0x0001,
+	0x117: 0x0001,
+	0x118: 0x0001,
+	0x11e: 0x0001, 0x11f: 0x0001, 0x120: 0x0001, 0x121: 0x0001,
+	0x126: 0x0001, 0x128: 0x0001, 0x129: 0x0001,
+	0x12a: 0x0001, 0x12c: 0x0001, 0x12d: 0x0001,
+	0x130: 0x0001, 0x132: 0x0001, 0x133: 0x0001,
+	0x137: 0x0001, 0x138: 0x0001, 0x139: 0x0001, 0x13a: 0x0001,
+	0x13c: 0x0001, 0x13e: 0x0001,
+	// Block 0x5, offset 0x140
+	0x141: 0x0001,
+	0x151: 0x0001,
+	0x153: 0x0001,
+	0x15b: 0x0001,
+	0x166: 0x0001, 0x167: 0x0001,
+	0x16b: 0x0001,
+	0x171: 0x0001, 0x172: 0x0001, 0x173: 0x0001,
+	0x178: 0x0001,
+	0x17f: 0x0001,
+	// Block 0x6, offset 0x180
+	0x180: 0x0001, 0x181: 0x0001, 0x182: 0x0001, 0x184: 0x0001,
+	0x188: 0x0001, 0x189: 0x0001, 0x18a: 0x0001, 0x18b: 0x0001,
+	0x18d: 0x0001,
+	0x192: 0x0001, 0x193: 0x0001,
+	0x1a6: 0x0001, 0x1a7: 0x0001,
+	0x1ab: 0x0001,
+	// Block 0x7, offset 0x1c0
+	0x1ce: 0x0001, 0x1d0: 0x0001,
+	0x1d2: 0x0001, 0x1d4: 0x0001, 0x1d6: 0x0001,
+	0x1d8: 0x0001, 0x1da: 0x0001, 0x1dc: 0x0001,
+	// Block 0x8, offset 0x200
+	0x211: 0x0001,
+	0x221: 0x0001,
+	// Block 0x9, offset 0x240
+	0x244: 0x0001,
+	0x247: 0x0001, 0x249: 0x0001, 0x24a: 0x0001, 0x24b: 0x0001,
+	0x24d: 0x0001, 0x250: 0x0001,
+	0x258: 0x0001, 0x259: 0x0001, 0x25a: 0x0001, 0x25b: 0x0001, 0x25d: 0x0001,
+	0x25f: 0x0001,
+	// Block 0xa, offset 0x280
+	0x280: 0x0021, 0x281: 0x0021, 0x282: 0x0021, 0x283: 0x0021, 0x284: 0x0021, 0x285: 0x0021,
+	0x286: 0x0021, 0x287: 0x0021, 0x288: 0x0021, 0x289: 0x0021, 0x28a: 0x0021, 0x28b: 0x0021,
+	0x28c: 0x0021, 0x28d: 0x0021, 0x28e: 0x0021, 0x28f: 0x0021, 0x290: 0x0021, 0x291: 0x0021,
+	0x292: 0x0021, 0x293: 0x0021, 0x294: 0x0021, 0x295: 0x0021, 0x296: 0x0021, 0x297: 0x0021,
+	0x298: 0x0021, 0x299: 0x0021, 0x29a: 0x0021, 0x29b: 0x0021, 0x29c: 0x0021, 0x29d: 0x0021,
+	0x29e: 0x0021, 0x29f: 0x0021, 0x2a0: 0x0021, 0x2a1: 0x0021, 0x2a2: 0x0021, 0x2a3: 0x0021,
+	0x2a4: 0x0021, 0x2a5: 0x0021, 0x2a6: 0x0021, 0x2a7: 0x0021, 0x2a8: 0x0021, 0x2a9: 0x0021,
+	0x2aa: 0x0021, 0x2ab: 0x0021, 0x2ac: 0x0021, 0x2ad: 0x0021, 0x2ae: 0x0021, 0x2af: 0x0021,
+	0x2b0: 0x0021, 0x2b1: 0x0021, 0x2b2: 0x0021, 0x2b3: 0x0021, 0x2b4: 0x0021, 0x2b5: 0x0021,
+	0x2b6: 0x0021, 0x2b7: 0x0021, 0x2b8: 0x0021, 0x2b9: 0x0021, 0x2ba: 0x0021, 0x2bb: 0x0021,
+	0x2bc: 0x0021, 0x2bd: 0x0021, 0x2be: 0x0021, 0x2bf: 0x0021,
+	// Block 0xb, offset 0x2c0
+	0x2c0: 0x0021, 0x2c1: 0x0021, 0x2c2: 0x0021, 0x2c3: 0x0021, 0x2c4: 0x0021, 0x2c5: 0x0021,
+	0x2c6: 0x0021, 0x2c7: 0x0021, 0x2c8: 0x0021, 0x2c9: 0x0021, 0x2ca: 0x0021, 0x2cb: 0x0021,
+	0x2cc: 0x0021, 0x2cd: 0x0021, 0x2ce: 0x0021, 0x2cf: 0x0021, 0x2d0: 0x0021, 0x2d1: 0x0021,
+	0x2d2: 0x0021, 0x2d3: 0x0021, 0x2d4: 0x0021, 0x2d5: 0x0021, 0x2d6: 0x0021, 0x2d7: 0x0021,
+	0x2d8: 0x0021, 0x2d9: 0x0021, 0x2da: 0x0021, 0x2db: 0x0021, 0x2dc: 0x0021, 0x2dd: 0x0021,
+	0x2de: 0x0021, 0x2df: 0x0021, 0x2e0: 0x0021, 0x2e1: 0x0021, 0x2e2: 0x0021, 0x2e3: 0x0021,
+	0x2e4: 0x0021, 0x2e5: 0x0021, 0x2e6: 0x0021, 0x2e7: 0x0021, 0x2e8: 0x0021, 0x2e9: 0x0021,
+	0x2ea: 0x0021, 0x2eb: 0x0021, 0x2ec: 0x0021, 0x2ed: 0x0021, 0x2ee: 0x0021, 0x2ef: 0x0021,
+	// Block 0xc, offset 0x300
+	0x311: 0x0001,
+	0x312: 0x0001, 0x313: 0x0001, 0x314: 0x0001, 0x315: 0x0001, 0x316: 0x0001, 0x317: 0x0001,
+	0x318: 0x0001, 0x319: 0x0001, 0x31a: 0x0001, 0x31b: 0x0001, 0x31c: 0x0001, 0x31d: 0x0001,
+	0x31e: 0x0001, 0x31f: 0x0001, 0x320: 0x0001, 0x321: 0x0001, 0x323: 0x0001,
+	0x324: 0x0001, 0x325: 0x0001, 0x326: 0x0001, 0x327: 0x0001, 0x328: 0x0001, 0x329: 0x0001,
+	0x331: 0x0001, 0x332: 0x0001, 0x333: 0x0001, 0x334: 0x0001, 0x335: 0x0001,
+	0x336: 0x0001, 0x337: 0x0001, 0x338: 0x0001, 0x339: 0x0001, 0x33a: 0x0001, 0x33b: 0x0001,
+	0x33c: 0x0001, 0x33d: 0x0001, 0x33e: 0x0001, 0x33f: 0x0001,
+	// Block 0xd, offset 0x340
+	0x340: 0x0001, 0x341: 0x0001, 0x343: 0x0001, 0x344: 0x0001, 0x345: 0x0001,
+	0x346: 0x0001, 0x347: 0x0001, 0x348: 0x0001, 0x349: 0x0001,
+	// Block 0xe, offset 0x380
+	0x381: 0x0001,
+	0x390: 0x0001, 0x391: 0x0001,
+	0x392: 0x0001, 0x393: 0x0001, 0x394: 0x0001, 0x395: 0x0001, 0x396: 0x0001, 0x397: 0x0001,
+	0x398: 0x0001, 0x399: 0x0001, 0x39a: 0x0001, 0x39b: 0x0001, 0x39c: 0x0001, 0x39d: 0x0001,
+	0x39e: 0x0001, 0x39f: 0x0001, 0x3a0: 0x0001, 0x3a1: 0x0001, 0x3a2: 0x0001, 0x3a3: 0x0001,
+	0x3a4: 0x0001, 0x3a5: 0x0001, 0x3a6: 0x0001, 0x3a7: 0x0001, 0x3a8: 0x0001, 0x3a9: 0x0001,
+	0x3aa: 0x0001, 0x3ab: 0x0001, 0x3ac: 0x0001, 0x3ad: 0x0001, 0x3ae: 0x0001, 0x3af: 0x0001,
+	0x3b0: 0x0001, 0x3b1: 0x0001, 0x3b2: 0x0001, 0x3b3: 0x0001, 0x3b4: 0x0001, 0x3b5: 0x0001,
+	0x3b6: 0x0001, 0x3b7: 0x0001, 0x3b8: 0x0001, 0x3b9: 0x0001, 0x3ba: 0x0001, 0x3bb: 0x0001,
+	0x3bc: 0x0001, 0x3bd: 0x0001, 0x3be: 0x0001, 0x3bf: 0x0001,
+	// Block 0xf, offset 0x3c0
+	0x3c0: 0x0001, 0x3c1: 0x0001, 0x3c2: 0x0001, 0x3c3: 0x0001, 0x3c4: 0x0001, 0x3c5: 0x0001,
+	0x3c6: 0x0001, 0x3c7: 0x0001, 0x3c8: 0x0001, 0x3c9: 0x0001, 0x3ca: 0x0001, 0x3cb: 0x0001,
+	0x3cc: 0x0001, 0x3cd: 0x0001, 0x3ce: 0x0001, 0x3cf: 0x0001, 0x3d1: 0x0001,
+	// Block 0x10, offset 0x400
+	0x403: 0x0020, 0x404: 0x0020, 0x405: 0x0020,
+	0x406: 0x0020, 0x407: 0x0020, 0x408: 0x0020, 0x409: 0x0020,
+	// Block 0x11, offset 0x440
+	0x451: 0x0020,
+	0x452: 0x0020, 0x453: 0x0020, 0x454: 0x0020, 0x455: 0x0020, 0x456: 0x0020, 0x457: 0x0020,
+	0x458: 0x0020, 0x459: 0x0020, 0x45a: 0x0020, 0x45b: 0x0020, 0x45c: 0x0020, 0x45d: 0x0020,
+	0x45e: 0x0020, 0x45f: 0x0020, 0x460: 0x0020, 0x461: 0x0020, 0x462: 0x0020, 0x463: 0x0020,
+	0x464: 0x0020, 0x465: 0x0020, 0x466: 0x0020, 0x467: 0x0020, 0x468: 0x0020, 0x469: 0x0020,
+	0x46a: 0x0020, 0x46b: 0x0020, 0x46c: 0x0020, 0x46d: 0x0020, 0x46e: 0x0020, 0x46f: 0x0020,
+	0x470: 0x0020, 0x471: 0x0020, 0x472: 0x0020, 0x473: 0x0020, 0x474: 0x0020, 0x475: 0x0020,
+	0x476: 0x0020, 0x477: 0x0020, 0x478: 0x0020, 0x479: 0x0020, 0x47a: 0x0020, 0x47b: 0x0020,
+	0x47c: 0x0020, 0x47d: 0x0020, 0x47f: 0x0020,
+	// Block 0x12, offset 0x480
+	0x481: 0x0020, 0x482: 0x0020, 0x484: 0x0020, 0x485: 0x0020,
+	0x487: 0x0020,
+	// Block 0x13, offset 0x4c0
+	0x4c0: 0x0020, 0x4c1: 0x0020, 0x4c2: 0x0020, 0x4c3: 0x0020, 0x4c4: 0x0020, 0x4c5: 0x0020,
+	0x4d0: 0x0020, 0x4d1: 0x0020,
+	0x4d2: 0x0020, 0x4d3: 0x0020, 0x4d4: 0x0020, 0x4d5: 0x0020, 0x4d6: 0x0020, 0x4d7: 0x0020,
+	0x4d8: 0x0020, 0x4d9: 0x0020, 0x4da: 0x0020, 0x4dc: 0x0020,
+	// Block 0x14, offset 0x500
+	0x50b: 0x0020,
+	0x50c: 0x0020, 0x50d: 0x0020, 0x50e: 0x0020, 0x50f: 0x0020, 0x510: 0x0020, 0x511: 0x0020,
+	0x512: 0x0020, 0x513: 0x0020, 0x514: 0x0020, 0x515: 0x0020, 0x516: 0x0020, 0x517: 0x0020,
+	0x518: 0x0020, 0x519: 0x0020, 0x51a: 0x0020, 0x51b: 0x0020, 0x51c: 0x0020, 0x51d: 0x0020,
+	0x51e: 0x0020, 0x51f: 0x0020,
+	0x530: 0x0020,
+	// Block 0x15, offset 0x540
+	0x556: 0x0020, 0x557: 0x0020,
+	0x558: 0x0020, 0x559: 0x0020, 0x55a: 0x0020, 0x55b: 0x0020, 0x55c: 0x0020, 0x55d: 0x0020,
+	0x55f: 0x0020, 0x560: 0x0020, 0x561: 0x0020, 0x562: 0x0020, 0x563: 0x0020,
+	0x564: 0x0020, 0x567: 0x0020, 0x568: 0x0020,
+	0x56a: 0x0020, 0x56b: 0x0020, 0x56c: 0x0020, 0x56d: 0x0020,
+	// Block 0x16, offset 0x580
+	0x58f: 0x0020, 0x591: 0x0020,
+	0x5b0: 0x0020, 0x5b1: 0x0020, 0x5b2: 0x0020, 0x5b3: 0x0020, 0x5b4: 0x0020, 0x5b5: 0x0020,
+	0x5b6: 0x0020, 0x5b7: 0x0020, 0x5b8: 0x0020, 0x5b9: 0x0020, 0x5ba: 0x0020, 0x5bb: 0x0020,
+	0x5bc: 0x0020, 0x5bd: 0x0020, 0x5be: 0x0020, 0x5bf: 0x0020,
+	// Block 0x17, offset 0x5c0
+	0x5c0: 0x0020, 0x5c1: 0x0020, 0x5c2: 0x0020, 0x5c3: 0x0020, 0x5c4: 0x0020, 0x5c5: 0x0020,
+	0x5c6: 0x0020, 0x5c7: 0x0020, 0x5c8: 0x0020, 0x5c9: 0x0020, 0x5ca: 0x0020,
+	// Block 0x18, offset 0x600
+	0x626: 0x0020, 0x627: 0x0020, 0x628: 0x0020, 0x629: 0x0020,
+	0x62a: 0x0020, 0x62b: 0x0020, 0x62c: 0x0020, 0x62d: 0x0020, 0x62e: 0x0020, 0x62f: 0x0020,
+	0x630: 0x0020,
+	// Block 0x19, offset 0x640
+	0x66b: 0x0020, 0x66c: 0x0020, 0x66d: 0x0020, 0x66e: 0x0020, 0x66f: 0x0020,
+	0x670: 0x0020, 0x671: 0x0020, 0x672: 0x0020, 0x673: 0x0020,
+	0x67d: 0x0020,
+	// Block 0x1a, offset 0x680
+	0x696: 0x0020, 0x697: 0x0020,
+	0x698: 0x0020, 0x699: 0x0020, 0x69b: 0x0020, 0x69c: 0x0020, 0x69d: 0x0020,
+	0x69e: 0x0020, 0x69f: 0x0020, 0x6a0: 0x0020, 0x6a1: 0x0020, 0x6a2: 0x0020, 0x6a3: 0x0020,
+	0x6a5: 0x0020, 0x6a6: 0x0020, 0x6a7: 0x0020, 0x6a9: 0x0020,
+	0x6aa: 0x0020, 0x6ab: 0x0020, 0x6ac: 0x0020, 0x6ad: 0x0020,
+	// Block 0x1b, offset 0x6c0
+	0x6d9: 0x0020, 0x6da: 0x0020, 0x6db: 0x0020,
+	// Block 0x1c, offset 0x700
+	0x710: 0x0020, 0x711: 0x0020,
+	0x717: 0x0020,
+	0x718: 0x0020, 0x719: 0x0020, 0x71a: 0x0020, 0x71b: 0x0020, 0x71c: 0x0020, 0x71d: 0x0020,
+	0x71e: 0x0020, 0x71f: 0x0020,
+	// Block 0x1d, offset 0x740
+	0x74a: 0x0020, 0x74b: 0x0020,
+	0x74c: 0x0020, 0x74d: 0x0020, 0x74e: 0x0020, 0x74f: 0x0020, 0x750: 0x0020, 0x751: 0x0020,
+	0x752: 0x0020, 0x753: 0x0020, 0x754: 0x0020, 0x755: 0x0020, 0x756: 0x0020, 0x757: 0x0020,
+	0x758: 0x0020, 0x759: 0x0020, 0x75a: 0x0020, 0x75b: 0x0020, 0x75c: 0x0020, 0x75d: 0x0020,
+	0x75e: 0x0020, 0x75f: 0x0020, 0x760: 0x0020, 0x761: 0x0020, 0x762: 0x0020, 0x763: 0x0020,
+	0x764: 0x0020, 0x765: 0x0020, 0x766: 0x0020, 0x767: 0x0020, 0x768: 0x0020, 0x769: 0x0020,
+	0x76a: 0x0020, 0x76b: 0x0020, 0x76c: 0x0020, 0x76d: 0x0020, 0x76e: 0x0020, 0x76f: 0x0020,
+	0x770: 0x0020, 0x771: 0x0020, 0x772: 0x0020, 0x773: 0x0020, 0x774: 0x0020, 0x775: 0x0020,
+	0x776: 0x0020, 0x777: 0x0020, 0x778: 0x0020, 0x779: 0x0020, 0x77a: 0x0020, 0x77b: 0x0020,
+	0x77c: 0x0020, 0x77d: 0x0020, 0x77e: 0x0020, 0x77f: 0x0020,
+	// Block 0x1e, offset 0x780
+	0x780: 0x0020, 0x781: 0x0020, 0x782: 0x0020,
+	0x7ba: 0x0020,
+	0x7bc: 0x0020,
+	// Block 0x1f, offset 0x7c0
+	0x7c1: 0x0020, 0x7c2: 0x0020, 0x7c3: 0x0020, 0x7c4: 0x0020, 0x7c5: 0x0020,
+	0x7c6: 0x0020, 0x7c7: 0x0020, 0x7c8: 0x0020,
+	0x7cd: 0x0020, 0x7d1: 0x0020,
+	0x7d2: 0x0020, 0x7d3: 0x0020, 0x7d4: 0x0020, 0x7d5: 0x0020, 0x7d6: 0x0020, 0x7d7: 0x0020,
+	0x7e2: 0x0020, 0x7e3: 0x0020,
+	// Block 0x20, offset 0x800
+	0x801: 0x0020,
+	0x83c: 0x0020,
+	// Block 0x21, offset 0x840
+	0x841: 0x0020, 0x842: 0x0020, 0x843: 0x0020, 0x844: 0x0020,
+	0x84d: 0x0020,
+	0x862: 0x0020, 0x863: 0x0020,
+	0x87e: 0x0020,
+	// Block 0x22, offset 0x880
+	0x881: 0x0020, 0x882: 0x0020,
+	0x8bc: 0x0020,
+	// Block 0x23, offset 0x8c0
+	0x8c1: 0x0020, 0x8c2: 0x0020,
+	0x8c7: 0x0020, 0x8c8: 0x0020, 0x8cb: 0x0020,
+	0x8cc: 0x0020, 0x8cd: 0x0020, 0x8d1: 0x0020,
+	0x8f0: 0x0020, 0x8f1: 0x0020, 0x8f5: 0x0020,
+	// Block 0x24, offset 0x900
+	0x901: 0x0020, 0x902: 0x0020, 0x903: 0x0020, 0x904: 0x0020, 0x905: 0x0020,
+	0x907: 0x0020, 0x908: 0x0020,
+	0x90d: 0x0020,
+	0x922: 0x0020, 0x923: 0x0020,
+	0x93a: 0x0020, 0x93b: 0x0020,
+	0x93c: 0x0020, 0x93d: 0x0020, 0x93e: 0x0020, 0x93f: 0x0020,
+	// Block 0x25, offset 0x940
+	0x941: 0x0020,
+	0x97c: 0x0020, 0x97f: 0x0020,
+	// Block 0x26, offset 0x980
+	0x981: 0x0020, 0x982: 0x0020, 0x983: 0x0020, 0x984: 0x0020,
+	0x98d: 0x0020,
+	0x995: 0x0020, 0x996: 0x0020,
+	0x9a2: 0x0020, 0x9a3: 0x0020,
+	// Block 0x27, offset 0x9c0
+	0x9c2: 0x0020,
+	// Block 0x28, offset 0xa00
+	0xa00: 0x0020,
+	0xa0d: 0x0020,
+	// Block 0x29, offset 0xa40
+	0xa40: 0x0020, 0xa44: 0x0020,
+	0xa7c: 0x0020, 0xa7e: 0x0020, 0xa7f: 0x0020,
+	// Block 0x2a, offset 0xa80
+	0xa80: 0x0020,
+	0xa86: 0x0020, 0xa87: 0x0020, 0xa88: 0x0020, 0xa8a: 0x0020, 0xa8b: 0x0020,
+	0xa8c: 0x0020, 0xa8d: 0x0020,
+	0xa95: 0x0020, 0xa96: 0x0020,
+	0xaa2: 0x0020, 0xaa3: 0x0020,
+	// Block 0x2b, offset 0xac0
+	0xac6: 0x0020,
+	0xacc: 0x0020, 0xacd: 0x0020,
+	0xae2: 0x0020, 0xae3: 0x0020,
+	// Block 0x2c, offset 0xb00
+	0xb00: 0x0020, 0xb01: 0x0020,
+	0xb3b: 0x0020,
+	0xb3c: 0x0020,
+	// Block 0x2d, offset 0xb40
+	0xb41: 0x0020, 0xb42: 0x0020, 0xb43: 0x0020, 0xb44: 0x0020,
+	0xb4d: 0x0020,
+	0xb62: 0x0020, 0xb63: 0x0020,
+	// Block 0x2e, offset 0xb80
+	0xb81: 0x0020,
+	// Block 0x2f, offset 0xbc0
+	0xbca: 0x0020,
+	0xbd2: 0x0020, 0xbd3: 0x0020, 0xbd4: 0x0020, 0xbd6: 0x0020,
+	// Block 0x30, offset 0xc00
+	0xc31: 0x0020, 0xc34: 0x0020, 0xc35: 0x0020,
+	0xc36: 0x0020, 0xc37: 0x0020, 0xc38: 0x0020, 0xc39: 0x0020, 0xc3a: 0x0020,
+	// Block 0x31, offset 0xc40
+	0xc47: 0x0020, 0xc48: 0x0020, 0xc49: 0x0020, 0xc4a: 0x0020, 0xc4b: 0x0020,
+	0xc4c: 0x0020, 0xc4d: 0x0020, 0xc4e: 0x0020,
+	// Block 0x32, offset 0xc80
+	0xcb1: 0x0020, 0xcb4: 0x0020, 0xcb5: 0x0020,
+	0xcb6: 0x0020, 0xcb7: 0x0020, 0xcb8: 0x0020, 0xcb9: 0x0020, 0xcba: 0x0020, 0xcbb: 0x0020,
+	0xcbc: 0x0020,
+	// Block 0x33, offset 0xcc0
+	0xcc8: 0x0020, 0xcc9: 0x0020, 0xcca: 0x0020, 0xccb: 0x0020,
+	0xccc: 0x0020, 0xccd: 0x0020, 0xcce: 0x0020,
+	// Block 0x34, offset 0xd00
+	0xd18: 0x0020, 0xd19: 0x0020,
+	0xd35: 0x0020,
+	0xd37: 0x0020, 0xd39: 0x0020,
+	// Block 0x35, offset 0xd40
+	0xd71: 0x0020, 0xd72: 0x0020, 0xd73: 0x0020, 0xd74: 0x0020, 0xd75: 0x0020,
+	0xd76: 0x0020, 0xd77: 0x0020, 0xd78: 0x0020, 0xd79: 0x0020, 0xd7a: 0x0020, 0xd7b: 0x0020,
+	0xd7c: 0x0020, 0xd7d: 0x0020, 0xd7e: 0x0020,
+	// Block 0x36, offset 0xd80
+	0xd80: 0x0020, 0xd81: 0x0020, 0xd82: 0x0020, 0xd83: 0x0020, 0xd84: 0x0020,
+	0xd86: 0x0020, 0xd87: 0x0020,
+	0xd8d: 0x0020, 0xd8e: 0x0020, 0xd8f: 0x0020, 0xd90: 0x0020, 0xd91: 0x0020,
+	0xd92: 0x0020, 0xd93: 0x0020, 0xd94: 0x0020, 0xd95: 0x0020, 0xd96: 0x0020, 0xd97: 0x0020,
+	0xd99: 0x0020, 0xd9a: 0x0020, 0xd9b: 0x0020, 0xd9c: 0x0020, 0xd9d: 0x0020,
+	0xd9e: 0x0020, 0xd9f: 0x0020, 0xda0: 0x0020, 0xda1: 0x0020, 0xda2: 0x0020, 0xda3: 0x0020,
+	0xda4: 0x0020, 0xda5: 0x0020, 0xda6: 0x0020, 0xda7: 0x0020, 0xda8: 0x0020, 0xda9: 0x0020,
+	0xdaa: 0x0020, 0xdab: 0x0020, 0xdac: 0x0020, 0xdad: 0x0020, 0xdae: 0x0020, 0xdaf: 0x0020,
+	0xdb0: 0x0020, 0xdb1: 0x0020, 0xdb2: 0x0020, 0xdb3: 0x0020, 0xdb4: 0x0020, 0xdb5: 0x0020,
+	0xdb6: 0x0020, 0xdb7: 0x0020, 0xdb8: 0x0020, 0xdb9: 0x0020, 0xdba: 0x0020, 0xdbb: 0x0020,
+	0xdbc: 0x0020,
+	// Block 0x37, offset 0xdc0
+	0xdc6: 0x0020,
+	// Block 0x38, offset 0xe00
+	0xe2d: 0x0020, 0xe2e: 0x0020, 0xe2f: 0x0020,
+	0xe30: 0x0020, 0xe32: 0x0020, 0xe33: 0x0020, 0xe34: 0x0020, 0xe35: 0x0020,
+	0xe36: 0x0020, 0xe37: 0x0020, 0xe39: 0x0020, 0xe3a: 0x0020,
+	0xe3d: 0x0020, 0xe3e: 0x0020,
+	// Block 0x39, offset 0xe40
+	0xe58: 0x0020, 0xe59: 0x0020,
+	0xe5e: 0x0020, 0xe5f: 0x0020, 0xe60: 0x0020,
+	0xe71: 0x0020, 0xe72: 0x0020, 0xe73: 0x0020, 0xe74: 0x0020,
+	// Block 0x3a, offset 0xe80
+	0xe82: 0x0020, 0xe85: 0x0020,
+	0xe86: 0x0020,
+	0xe8d: 0x0020,
+	0xe9d: 0x0020,
+	// Block 0x3b, offset 0xec0
+	0xec0: 0x0010, 0xec1: 0x0010, 0xec2: 0x0010, 0xec3: 0x0010, 0xec4: 0x0010, 0xec5: 0x0010,
+	0xec6: 0x0010, 0xec7: 0x0010, 0xec8: 0x0010, 0xec9: 0x0010, 0xeca: 0x0010, 0xecb: 0x0010,
+	0xecc: 0x0010, 0xecd: 0x0010, 0xece: 0x0010, 0xecf: 0x0010, 0xed0: 0x0010, 0xed1: 0x0010,
+	0xed2: 0x0010, 0xed3: 0x0010, 0xed4: 0x0010, 0xed5: 0x0010, 0xed6: 0x0010, 0xed7: 0x0010,
+	0xed8: 0x0010, 0xed9: 0x0010, 0xeda: 0x0010, 0xedb: 0x0010, 0xedc: 0x0010, 0xedd: 0x0010,
+	0xede: 0x0010, 0xedf: 0x0010, 0xee0: 0x0010, 0xee1: 0x0010, 0xee2: 0x0010, 0xee3: 0x0010,
+	0xee4: 0x0010, 0xee5: 0x0010, 0xee6: 0x0010, 0xee7: 0x0010, 0xee8: 0x0010, 0xee9: 0x0010,
+	0xeea: 0x0010, 0xeeb: 0x0010, 0xeec: 0x0010, 0xeed: 0x0010, 0xeee: 0x0010, 0xeef: 0x0010,
+	0xef0: 0x0010, 0xef1: 0x0010, 0xef2: 0x0010, 0xef3: 0x0010, 0xef4: 0x0010, 0xef5: 0x0010,
+	0xef6: 0x0010, 0xef7: 0x0010, 0xef8: 0x0010, 0xef9: 0x0010, 0xefa: 0x0010, 0xefb: 0x0010,
+	0xefc: 0x0010, 0xefd: 0x0010, 0xefe: 0x0010, 0xeff: 0x0010,
+	// Block 0x3c, offset 0xf00
+	0xf00: 0x0010, 0xf01: 0x0010, 0xf02: 0x0010, 0xf03: 0x0010, 0xf04: 0x0010, 0xf05: 0x0010,
+	0xf06: 0x0010, 0xf07: 0x0010, 0xf08: 0x0010, 0xf09: 0x0010, 0xf0a: 0x0010, 0xf0b: 0x0010,
+	0xf0c: 0x0010, 0xf0d: 0x0010, 0xf0e: 0x0010, 0xf0f: 0x0010, 0xf10: 0x0010, 0xf11: 0x0010,
+	0xf12: 0x0010, 0xf13: 0x0010, 0xf14: 0x0010, 0xf15: 0x0010, 0xf16: 0x0010, 0xf17: 0x0010,
+	0xf18: 0x0010, 0xf19: 0x0010, 0xf1a: 0x0010, 0xf1b: 0x0010, 0xf1c: 0x0010, 0xf1d: 0x0010,
+	0xf1e: 0x0010, 0xf1f: 0x0010, 0xf20: 0x0020, 0xf21: 0x0020, 0xf22: 0x0020, 0xf23: 0x0020,
+	0xf24: 0x0020, 0xf25: 0x0020, 0xf26: 0x0020, 0xf27: 0x0020, 0xf28: 0x0020, 0xf29: 0x0020,
+	0xf2a: 0x0020, 0xf2b: 0x0020, 0xf2c: 0x0020, 0xf2d: 0x0020, 0xf2e: 0x0020, 0xf2f: 0x0020,
+	0xf30: 0x0020, 0xf31: 0x0020, 0xf32: 0x0020, 0xf33: 0x0020, 0xf34: 0x0020, 0xf35: 0x0020,
+	0xf36: 0x0020, 0xf37: 0x0020, 0xf38: 0x0020, 0xf39: 0x0020, 0xf3a: 0x0020, 0xf3b: 0x0020,
+	0xf3c: 0x0020, 0xf3d: 0x0020, 0xf3e: 0x0020, 0xf3f: 0x0020,
+	// Block 0x3d, offset 0xf40
+	0xf40: 0x0020, 0xf41: 0x0020, 0xf42: 0x0020, 0xf43: 0x0020, 0xf44: 0x0020, 0xf45: 0x0020,
+	0xf46: 0x0020, 0xf47: 0x0020, 0xf48: 0x0020, 0xf49: 0x0020, 0xf4a: 0x0020, 0xf4b: 0x0020,
+	0xf4c: 0x0020, 0xf4d: 0x0020, 0xf4e: 0x0020, 0xf4f: 0x0020, 0xf50: 0x0020, 0xf51: 0x0020,
+	0xf52: 0x0020, 0xf53: 0x0020, 0xf54: 0x0020, 0xf55: 0x0020, 0xf56: 0x0020, 0xf57: 0x0020,
+	0xf58: 0x0020, 0xf59: 0x0020, 0xf5a: 0x0020, 0xf5b: 0x0020, 0xf5c: 0x0020, 0xf5d: 0x0020,
+	0xf5e: 0x0020, 0xf5f: 0x0020, 0xf60: 0x0020, 0xf61: 0x0020, 0xf62: 0x0020, 0xf63: 0x0020,
+	0xf64: 0x0020, 0xf65: 0x0020, 0xf66: 0x0020, 0xf67: 0x0020, 0xf68: 0x0020, 0xf69: 0x0020,
+	0xf6a: 0x0020, 0xf6b: 0x0020, 0xf6c: 0x0020, 0xf6d: 0x0020, 0xf6e: 0x0020, 0xf6f: 0x0020,
+	0xf70: 0x0020, 0xf71: 0x0020, 0xf72: 0x0020, 0xf73: 0x0020, 0xf74: 0x0020, 0xf75: 0x0020,
+	0xf76: 0x0020, 0xf77: 0x0020, 0xf78: 0x0020, 0xf79: 0x0020, 0xf7a: 0x0020, 0xf7b: 0x0020,
+	0xf7c: 0x0020, 0xf7d: 0x0020, 0xf7e: 0x0020, 0xf7f: 0x0020,
+	// Block 0x3e, offset 0xf80
+	0xf9d: 0x0020,
+	0xf9e: 0x0020, 0xf9f: 0x0020,
+	// Block 0x3f, offset 0xfc0
+	0xfd2: 0x0020, 0xfd3: 0x0020, 0xfd4: 0x0020,
+	0xff2: 0x0020, 0xff3: 0x0020,
+	// Block 0x40, offset 0x1000
+	0x1012: 0x0020, 0x1013: 0x0020,
+	0x1032: 0x0020, 0x1033: 0x0020,
+	// Block 0x41, offset 0x1040
+	0x1074: 0x0020, 0x1075: 0x0020,
+	0x1077: 0x0020, 0x1078: 0x0020, 0x1079: 0x0020, 0x107a: 0x0020, 0x107b: 0x0020,
+	0x107c: 0x0020, 0x107d: 0x0020,
+	// Block 0x42, offset 0x1080
+	0x1086: 0x0020, 0x1089: 0x0020, 0x108a: 0x0020, 0x108b: 0x0020,
+	0x108c: 0x0020, 0x108d: 0x0020, 0x108e: 0x0020, 0x108f: 0x0020, 0x1090: 0x0020, 0x1091: 0x0020,
+	0x1092: 0x0020, 0x1093: 0x0020,
+	0x109d: 0x0020,
+	// Block 0x43, offset 0x10c0
+	0x10cb: 0x0020,
+	0x10cc: 0x0020, 0x10cd: 0x0020, 0x10ce: 0x0020, 0x10cf: 0x0020,
+	// Block 0x44, offset 0x1100
+	0x1105: 0x0020,
+	0x1106: 0x0020,
+	0x1129: 0x0020,
+	// Block 0x45, offset 0x1140
+	0x1160: 0x0020, 0x1161: 0x0020, 0x1162: 0x0020,
+	0x1167: 0x0020, 0x1168: 0x0020,
+	0x1172: 0x0020,
+	0x1179: 0x0020, 0x117a: 0x0020, 0x117b: 0x0020,
+	// Block 0x46, offset 0x1180
+	0x1197: 0x0020,
+	0x1198: 0x0020, 0x119b: 0x0020,
+	// Block 0x47, offset 0x11c0
+	0x11d6: 0x0020,
+	0x11d8: 0x0020, 0x11d9: 0x0020, 0x11da: 0x0020, 0x11db: 0x0020, 0x11dc: 0x0020, 0x11dd: 0x0020,
+	0x11de: 0x0020, 0x11e0: 0x0020, 0x11e2: 0x0020,
+	0x11e5: 0x0020, 0x11e6: 0x0020, 0x11e7: 0x0020, 0x11e8: 0x0020, 0x11e9: 0x0020,
+	0x11ea: 0x0020, 0x11eb: 0x0020, 0x11ec: 0x0020,
+	0x11f3: 0x0020, 0x11f4: 0x0020, 0x11f5: 0x0020,
+	0x11f6: 0x0020, 0x11f7: 0x0020, 0x11f8: 0x0020, 0x11f9: 0x0020, 0x11fa: 0x0020, 0x11fb: 0x0020,
+	0x11fc: 0x0020, 0x11ff: 0x0020,
+	// Block 0x48, offset 0x1200
+	0x1230: 0x0020, 0x1231: 0x0020, 0x1232: 0x0020, 0x1233: 0x0020, 0x1234: 0x0020, 0x1235: 0x0020,
+	0x1236: 0x0020, 0x1237: 0x0020, 0x1238: 0x0020, 0x1239: 0x0020, 0x123a: 0x0020, 0x123b: 0x0020,
+	0x123c: 0x0020, 0x123d: 0x0020, 0x123e: 0x0020, 0x123f: 0x0020,
+	// Block 0x49, offset 0x1240
+	0x1240: 0x0020, 0x1241: 0x0020, 0x1242: 0x0020, 0x1243: 0x0020, 0x1244: 0x0020, 0x1245: 0x0020,
+	0x1246: 0x0020, 0x1247: 0x0020, 0x1248: 0x0020, 0x1249: 0x0020, 0x124a: 0x0020, 0x124b: 0x0020,
+	0x124c: 0x0020, 0x124d: 0x0020, 0x124e: 0x0020, 0x124f: 0x0020, 0x1250: 0x0020, 0x1251: 0x0020,
+	0x1252: 0x0020, 0x1253: 0x0020, 0x1254: 0x0020, 0x1255: 0x0020, 0x1256: 0x0020, 0x1257: 0x0020,
+	0x1258: 0x0020, 0x1259: 0x0020, 0x125a: 0x0020, 0x125b: 0x0020, 0x125c: 0x0020, 0x125d: 0x0020,
+	0x1260: 0x0020, 0x1261: 0x0020, 0x1262: 0x0020, 0x1263: 0x0020,
+	0x1264: 0x0020, 0x1265: 0x0020, 0x1266: 0x0020, 0x1267: 0x0020, 0x1268: 0x0020, 0x1269: 0x0020,
+	0x126a: 0x0020, 0x126b: 0x0020,
+	// Block 0x4a, offset 0x1280
+	0x1280: 0x0020, 0x1281: 0x0020, 0x1282: 0x0020, 0x1283: 0x0020,
+	0x12b4: 0x0020,
+	0x12b6: 0x0020, 0x12b7: 0x0020, 0x12b8: 0x0020, 0x12b9: 0x0020, 0x12ba: 0x0020,
+	0x12bc: 0x0020,
+	// Block 0x4b, offset 0x12c0
+	0x12c2: 0x0020,
+	0x12eb: 0x0020, 0x12ec: 0x0020, 0x12ed: 0x0020, 0x12ee: 0x0020, 0x12ef: 0x0020,
+	0x12f0: 0x0020, 0x12f1: 0x0020, 0x12f2: 0x0020, 0x12f3: 0x0020,
+	// Block 0x4c, offset 0x1300
+	0x1300: 0x0020, 0x1301: 0x0020,
+	0x1322: 0x0020, 0x1323: 0x0020,
+	0x1324: 0x0020, 0x1325: 0x0020, 0x1328: 0x0020, 0x1329: 0x0020,
+	0x132b: 0x0020, 0x132c: 0x0020, 0x132d: 0x0020,
+	// Block 0x4d, offset 0x1340
+	0x1366: 0x0020, 0x1368: 0x0020, 0x1369: 0x0020,
+	0x136d: 0x0020, 0x136f: 0x0020,
+	0x1370: 0x0020, 0x1371: 0x0020,
+	// Block 0x4e, offset 0x1380
+	0x13ac: 0x0020, 0x13ad: 0x0020, 0x13ae: 0x0020, 0x13af: 0x0020,
+	0x13b0: 0x0020, 0x13b1: 0x0020, 0x13b2: 0x0020, 0x13b3: 0x0020,
+	0x13b6: 0x0020, 0x13b7: 0x0020,
+	// Block 0x4f, offset 0x13c0
+	0x13d0: 0x0020, 0x13d1: 0x0020,
+	0x13d2: 0x0020, 0x13d4: 0x0020, 0x13d5: 0x0020, 0x13d6: 0x0020, 0x13d7: 0x0020,
+	0x13d8: 0x0020, 0x13d9: 0x0020, 0x13da: 0x0020, 0x13db: 0x0020, 0x13dc: 0x0020, 0x13dd: 0x0020,
+	0x13de: 0x0020, 0x13df: 0x0020, 0x13e0: 0x0020, 0x13e2: 0x0020, 0x13e3: 0x0020,
+	0x13e4: 0x0020, 0x13e5: 0x0020, 0x13e6: 0x0020, 0x13e7: 0x0020, 0x13e8: 0x0020,
+	0x13ed: 0x0020,
+	0x13f4: 0x0020,
+	0x13f8: 0x0020, 0x13f9: 0x0020,
+	// Block 0x50, offset 0x1400
+	0x140b: 0x0020,
+	0x140c: 0x0020, 0x140d: 0x0020, 0x140e: 0x0020, 0x140f: 0x0020, 0x1410: 0x0001,
+	0x1413: 0x0001, 0x1414: 0x0001, 0x1415: 0x0001, 0x1416: 0x0001,
+	0x1418: 0x0001, 0x1419: 0x0001, 0x141c: 0x0001, 0x141d: 0x0001,
+	0x1420: 0x0001, 0x1421: 0x0001, 0x1422: 0x0001,
+	0x1424: 0x0001, 0x1425: 0x0001, 0x1426: 0x0001, 0x1427: 0x0001,
+	0x142a: 0x0020, 0x142b: 0x0020, 0x142c: 0x0020, 0x142d: 0x0020, 0x142e: 0x0020,
+	0x1430: 0x0001, 0x1432: 0x0001, 0x1433: 0x0001, 0x1435: 0x0001,
+	0x143b: 0x0001,
+	0x143c: 0x0004, 0x143e: 0x0001,
+	// Block 0x51, offset 0x1440
+	0x1449: 0x0004,
+	0x1460: 0x0020, 0x1461: 0x0020, 0x1462: 0x0020, 0x1463: 0x0020,
+	0x1464: 0x0020, 0x1466: 0x0020, 0x1467: 0x0020, 0x1468: 0x0020, 0x1469: 0x0020,
+	0x146a: 0x0020, 0x146b: 0x0020, 0x146c: 0x0020, 0x146d: 0x0020, 0x146e: 0x0020, 0x146f: 0x0020,
+	0x1474: 0x0001,
+	0x147f: 0x0001,
+	// Block 0x52, offset 0x1480
+	0x1481: 0x0001, 0x1482: 0x0001, 0x1483: 0x0001, 0x1484: 0x0001,
+	0x14ac: 0x0001,
+	// Block 0x53, offset 0x14c0
+	0x14d0: 0x0020, 0x14d1: 0x0020,
+	0x14d2: 0x0020, 0x14d3: 0x0020, 0x14d4: 0x0020, 0x14d5: 0x0020, 0x14d6: 0x0020, 0x14d7: 0x0020,
+	0x14d8: 0x0020, 0x14d9: 0x0020, 0x14da: 0x0020, 0x14db: 0x0020, 0x14dc: 0x0020, 0x14dd: 0x0020,
+	0x14de: 0x0020, 0x14df: 0x0020, 0x14e0: 0x0020, 0x14e1: 0x0020, 0x14e2: 0x0020, 0x14e3: 0x0020,
+	0x14e4: 0x0020, 0x14e5: 0x0020, 0x14e6: 0x0020, 0x14e7: 0x0020, 0x14e8: 0x0020, 0x14e9: 0x0020,
+	0x14ea: 0x0020, 0x14eb: 0x0020, 0x14ec: 0x0020, 0x14ed: 0x0020, 0x14ee: 0x0020, 0x14ef: 0x0020,
+	0x14f0: 0x0020,
+	// Block 0x54, offset 0x1500
+	0x1503: 0x0001, 0x1505: 0x0001,
+	0x1509: 0x0001,
+	0x1513: 0x0001, 0x1516: 0x0001,
+	0x1521: 0x0001, 0x1522: 0x0005,
+	0x1526: 0x0001,
+	0x152b: 0x0001,
+	0x1539: 0x0004,
+	// Block 0x55, offset 0x1540
+	0x1553: 0x0001, 0x1554: 0x0001,
+	0x155b: 0x0001, 0x155c: 0x0001, 0x155d: 0x0001,
+	0x155e: 0x0001, 0x1560: 0x0001, 0x1561: 0x0001, 0x1562: 0x0001, 0x1563: 0x0001,
+	0x1564: 0x0001, 0x1565: 0x0001, 0x1566: 0x0001, 0x1567: 0x0001, 0x1568: 0x0001, 0x1569: 0x0001,
+	0x156a: 0x0001, 0x156b: 0x0001,
+	0x1570: 0x0001, 0x1571: 0x0001, 0x1572: 0x0001, 0x1573: 0x0001, 0x1574: 0x0001, 0x1575: 0x0001,
+	0x1576: 0x0001, 0x1577: 0x0001, 0x1578: 0x0001, 0x1579: 0x0001,
+	// Block 0x56, offset 0x1580
+	0x1589: 0x0001,
+	0x1590: 0x0001, 0x1591: 0x0001,
+	0x1592: 0x0001, 0x1593: 0x0001, 0x1594: 0x0005, 0x1595: 0x0005, 0x1596: 0x0005, 0x1597: 0x0005,
+	0x1598: 0x0005, 0x1599: 0x0005,
+	0x15a9: 0x0004,
+	0x15aa: 0x0004,
+	0x15b8: 0x0001, 0x15b9: 0x0001,
+	// Block 0x57, offset 0x15c0
+	0x15d2: 0x0001, 0x15d4: 0x0001,
+	0x15e7: 0x0001,
+	// Block 0x58, offset 0x1600
+	0x1600: 0x0001, 0x1602: 0x0001, 0x1603: 0x0001,
+	0x1607: 0x0001, 0x1608: 0x0001, 0x160b: 0x0001,
+	0x160f: 0x0001, 0x1611: 0x0001,
+	0x1615: 0x0001,
+	0x161a: 0x0001, 0x161d: 0x0001,
+	0x161e: 0x0001, 0x161f: 0x0001, 0x1620: 0x0001, 0x1623: 0x0001,
+	0x1625: 0x0001, 0x1627: 0x0001, 0x1628: 0x0001, 0x1629: 0x0001,
+	0x162a: 0x0001, 0x162b: 0x0001, 0x162c: 0x0001, 0x162e: 0x0001,
+	0x1634: 0x0001, 0x1635: 0x0001,
+	0x1636: 0x0001, 0x1637: 0x0001,
+	0x163c: 0x0001, 0x163d: 0x0001,
+	// Block 0x59, offset 0x1640
+	0x1648: 0x0001,
+	0x164c: 0x0001,
+	0x1652: 0x0001,
+	0x1660: 0x0001, 0x1661: 0x0001,
+	0x1664: 0x0001, 0x1665: 0x0001, 0x1666: 0x0001, 0x1667: 0x0001,
+	0x166a: 0x0001, 0x166b: 0x0001, 0x166e: 0x0001, 0x166f: 0x0001,
+	// Block 0x5a, offset 0x1680
+	0x1682: 0x0001, 0x1683: 0x0001,
+	0x1686: 0x0001, 0x1687: 0x0001,
+	0x1695: 0x0001,
+	0x1699: 0x0001,
+	0x16a5: 0x0001,
+	0x16bf: 0x0001,
+	// Block 0x5b, offset 0x16c0
+	0x16d2: 0x0001,
+	0x16da: 0x001c, 0x16db: 0x001c,
+	0x16e8: 0x0004, 0x16e9: 0x0010,
+	0x16ea: 0x0010,
+	// Block 0x5c, offset 0x1700
+	0x170f: 0x0004,
+	0x1729: 0x001c,
+	0x172a: 0x001c, 0x172b: 0x001c, 0x172c: 0x001c, 0x172d: 0x0004, 0x172e: 0x0004, 0x172f: 0x0004,
+	0x1730: 0x001c, 0x1731: 0x0004, 0x1732: 0x0004, 0x1733: 0x001c,
+	0x1738: 0x0004, 0x1739: 0x0004, 0x173a: 0x0004,
+	// Block 0x5d, offset 0x1740
+	0x1760: 0x0001, 0x1761: 0x0001, 0x1762: 0x0001, 0x1763: 0x0001,
+	0x1764: 0x0001, 0x1765: 0x0001, 0x1766: 0x0001, 0x1767: 0x0001, 0x1768: 0x0001, 0x1769: 0x0001,
+	0x176a: 0x0001, 0x176b: 0x0001, 0x176c: 0x0001, 0x176d: 0x0001, 0x176e: 0x0001, 0x176f: 0x0001,
+	0x1770: 0x0001, 0x1771: 0x0001, 0x1772: 0x0001, 0x1773: 0x0001, 0x1774: 0x0001, 0x1775: 0x0001,
+	0x1776: 0x0001, 0x1777: 0x0001, 0x1778: 0x0001, 0x1779: 0x0001, 0x177a: 0x0001, 0x177b: 0x0001,
+	0x177c: 0x0001, 0x177d: 0x0001, 0x177e: 0x0001, 0x177f: 0x0001,
+	// Block 0x5e, offset 0x1780
+	0x1780: 0x0001, 0x1781: 0x0001, 0x1782: 0x0001, 0x1783: 0x0001, 0x1784: 0x0001, 0x1785: 0x0001,
+	0x1786: 0x0001, 0x1787: 0x0001, 0x1788: 0x0001, 0x1789: 0x0001, 0x178a: 0x0001, 0x178b: 0x0001,
+	0x178c: 0x0001, 0x178d: 0x0001, 0x178e: 0x0001, 0x178f: 0x0001, 0x1790: 0x0001, 0x1791: 0x0001,
+	0x1792: 0x0001, 0x1793: 0x0001, 0x1794: 0x0001, 0x1795: 0x0001, 0x1796: 0x0001, 0x1797: 0x0001,
+	0x1798: 0x0001, 0x1799: 0x0001, 0x179a: 0x0001, 0x179b: 0x0001, 0x179c: 0x0001, 0x179d: 0x0001,
+	0x179e: 0x0001, 0x179f: 0x0001, 0x17a0: 0x0001, 0x17a1: 0x0001, 0x17a2: 0x0001, 0x17a3: 0x0001,
+	0x17a4: 0x0001, 0x17a5: 0x0001, 0x17a6: 0x0001, 0x17a7: 0x0001, 0x17a8: 0x0001, 0x17a9: 0x0001,
+	0x17aa: 0x0001, 0x17ab: 0x0001, 0x17ac: 0x0001, 0x17ad: 0x0001, 0x17ae: 0x0001, 0x17af: 0x0001,
+	0x17b0: 0x0001, 0x17b1: 0x0001, 0x17b2: 0x0001, 0x17b3: 0x0001, 0x17b4: 0x0001, 0x17b5: 0x0001,
+	0x17b6: 0x0001, 0x17b7: 0x0001, 0x17b8: 0x0001, 0x17b9: 0x0001, 0x17ba: 0x0001, 0x17bb: 0x0001,
+	0x17bc: 0x0001, 0x17bd: 0x0001, 0x17be: 0x0001, 0x17bf: 0x0001,
+	// Block 0x5f, offset 0x17c0
+	0x17c0: 0x0001, 0x17c1: 0x0001, 0x17c2: 0x0005, 0x17c3: 0x0001, 0x17c4: 0x0001, 0x17c5: 0x0001,
+	0x17c6: 0x0001, 0x17c7: 0x0001, 0x17c8: 0x0001, 0x17c9: 0x0001, 0x17ca: 0x0001, 0x17cb: 0x0001,
+	0x17cc: 0x0001, 0x17cd: 0x0001, 0x17ce: 0x0001, 0x17cf: 0x0001, 0x17d0: 0x0001, 0x17d1: 0x0001,
+	0x17d2: 0x0001, 0x17d3: 0x0001, 0x17d4: 0x0001, 0x17d5: 0x0001, 0x17d6: 0x0001, 0x17d7: 0x0001,
+	0x17d8: 0x0001, 0x17d9: 0x0001, 0x17da: 0x0001, 0x17db: 0x0001, 0x17dc: 0x0001, 0x17dd: 0x0001,
+	0x17de: 0x0001, 0x17df: 0x0001, 0x17e0: 0x0001, 0x17e1: 0x0001, 0x17e2: 0x0001, 0x17e3: 0x0001,
+	0x17e4: 0x0001, 0x17e5: 0x0001, 0x17e6: 0x0001, 0x17e7: 0x0001, 0x17e8: 0x0001, 0x17e9: 0x0001,
+	0x17eb: 0x0001, 0x17ec: 0x0001, 0x17ed: 0x0001, 0x17ee: 0x0001, 0x17ef: 0x0001,
+	0x17f0: 0x0001, 0x17f1: 0x0001, 0x17f2: 0x0001, 0x17f3: 0x0001, 0x17f4: 0x0001, 0x17f5: 0x0001,
+	0x17f6: 0x0001, 0x17f7: 0x0001, 0x17f8: 0x0001, 0x17f9: 0x0001, 0x17fa: 0x0001, 0x17fb: 0x0001,
+	0x17fc: 0x0001, 0x17fd: 0x0001, 0x17fe: 0x0001, 0x17ff: 0x0001,
+	// Block 0x60, offset 0x1800
+	0x1800: 0x0001, 0x1801: 0x0001, 0x1802: 0x0001, 0x1803: 0x0001, 0x1804: 0x0001, 0x1805: 0x0001,
+	0x1806: 0x0001, 0x1807: 0x0001, 0x1808: 0x0001, 0x1809: 0x0001, 0x180a: 0x0001, 0x180b: 0x0001,
+	0x1810: 0x0001, 0x1811: 0x0001,
+	0x1812: 0x0001, 0x1813: 0x0001, 0x1814: 0x0001, 0x1815: 0x0001, 0x1816: 0x0001, 0x1817: 0x0001,
+	0x1818: 0x0001, 0x1819: 0x0001, 0x181a: 0x0001, 0x181b: 0x0001, 0x181c: 0x0001, 0x181d: 0x0001,
+	0x181e: 0x0001, 0x181f: 0x0001, 0x1820: 0x0001, 0x1821: 0x0001, 0x1822: 0x0001, 0x1823: 0x0001,
+	0x1824: 0x0001, 0x1825: 0x0001, 0x1826: 0x0001, 0x1827: 0x0001, 0x1828: 0x0001, 0x1829: 0x0001,
+	0x182a: 0x0001, 0x182b: 0x0001, 0x182c: 0x0001, 0x182d: 0x0001, 0x182e: 0x0001, 0x182f: 0x0001,
+	0x1830: 0x0001, 0x1831: 0x0001, 0x1832: 0x0001, 0x1833: 0x0001,
+	// Block 0x61, offset 0x1840
+	0x1840: 0x0001, 0x1841: 0x0001, 0x1842: 0x0001, 0x1843: 0x0001, 0x1844: 0x0001, 0x1845: 0x0001,
+	0x1846: 0x0001, 0x1847: 0x0001, 0x1848: 0x0001, 0x1849: 0x0001, 0x184a: 0x0001, 0x184b: 0x0001,
+	0x184c: 0x0001, 0x184d: 0x0001, 0x184e: 0x0001, 0x184f: 0x0001,
+	0x1852: 0x0001, 0x1853: 0x0001, 0x1854: 0x0001, 0x1855: 0x0001,
+	0x1860: 0x0001, 0x1861: 0x0001, 0x1863: 0x0001,
+	0x1864: 0x0001, 0x1865: 0x0001, 0x1866: 0x0001, 0x1867: 0x0001, 0x1868: 0x0001, 0x1869: 0x0001,
+	0x186a: 0x0004, 0x186b: 0x0004,
+	0x1872: 0x0001, 0x1873: 0x0001,
+	0x1876: 0x0005, 0x1877: 0x0001,
+	0x187c: 0x0001, 0x187d: 0x0001,
+	// Block 0x62, offset 0x1880
+	0x1880: 0x0005, 0x1881: 0x0001,
+	0x1886: 0x0001, 0x1887: 0x0001, 0x1888: 0x0001, 0x188b: 0x0001,
+	0x188e: 0x0001, 0x188f: 0x0001, 0x1890: 0x0001, 0x1891: 0x0001,
+	0x18a2: 0x0001, 0x18a3: 0x0001,
+	0x18a4: 0x0001, 0x18a5: 0x0001,
+	0x18af: 0x0001,
+	0x18bb: 0x0004,
+	0x18bc: 0x0004, 0x18bd: 0x001c, 0x18be: 0x001c,
+	// Block 0x63, offset 0x18c0
+	0x18c0: 0x0004, 0x18c1: 0x0004, 0x18c2: 0x0004, 0x18c3: 0x0004, 0x18c4: 0x0004, 0x18c5: 0x0001,
+	0x18c6: 0x0001, 0x18c9: 0x0001,
+	0x18ce: 0x0005, 0x18cf: 0x0001, 0x18d1: 0x0004,
+	0x18d4: 0x001c, 0x18d5: 0x001c,
+	0x18d8: 0x0004, 0x18dc: 0x0001, 0x18dd: 0x0004,
+	0x18de: 0x0001, 0x18e0: 0x0004, 0x18e2: 0x0004, 0x18e3: 0x0004,
+	0x18e6: 0x0004,
+	0x18ea: 0x0004, 0x18ee: 0x0004, 0x18ef: 0x0004,
+	0x18f0: 0x0010, 0x18f1: 0x0010, 0x18f2: 0x0010, 0x18f3: 0x0010, 0x18f4: 0x0010, 0x18f5: 0x0010,
+	0x18f6: 0x0010, 0x18f7: 0x0010, 0x18f8: 0x0004, 0x18f9: 0x0004, 0x18fa: 0x0004,
+	// Block 0x64, offset 0x1900
+	0x1900: 0x0005, 0x1902: 0x0005,
+	0x1908: 0x001c, 0x1909: 0x001c, 0x190a: 0x001c, 0x190b: 0x001c,
+	0x190c: 0x001c, 0x190d: 0x001c, 0x190e: 0x001c, 0x190f: 0x001c, 0x1910: 0x001c, 0x1911: 0x001c,
+	0x1912: 0x001c, 0x1913: 0x001c,
+	0x191f: 0x0004, 0x1920: 0x0005, 0x1921: 0x0001, 0x1923: 0x0005,
+	0x1924: 0x0001, 0x1925: 0x0005, 0x1926: 0x0004, 0x1927: 0x0001, 0x1928: 0x0005, 0x1929: 0x0001,
+	0x192a: 0x0001, 0x192c: 0x0001, 0x192d: 0x0001, 0x192f: 0x0001,
+	0x193b: 0x0004,
+	0x193e: 0x0004, 0x193f: 0x001c,
+	// Block 0x65, offset 0x1940
+	0x194a: 0x0010, 0x194b: 0x0010,
+	0x194c: 0x0010, 0x194d: 0x0010, 0x194e: 0x0010, 0x194f: 0x0010,
+	0x1952: 0x0004, 0x1953: 0x001c, 0x1954: 0x0004, 0x1955: 0x0004, 0x1956: 0x0004, 0x1957: 0x0004,
+	0x1959: 0x0004, 0x195b: 0x0004, 0x195c: 0x0004,
+	0x195e: 0x0001, 0x195f: 0x0001, 0x1960: 0x0004, 0x1961: 0x001c,
+	0x1967: 0x0004,
+	0x196a: 0x001c, 0x196b: 0x001c,
+	0x1970: 0x0004, 0x1971: 0x0004,
+	0x197d: 0x001c, 0x197e: 0x001c, 0x197f: 0x0001,
+	// Block 0x66, offset 0x1980
+	0x1984: 0x001c, 0x1985: 0x001c,
+	0x1986: 0x0001, 0x1987: 0x0001, 0x1988: 0x0005, 0x1989: 0x0001, 0x198a: 0x0001, 0x198b: 0x0001,
+	0x198c: 0x0001, 0x198d: 0x0001, 0x198e: 0x001c, 0x198f: 0x0005, 0x1990: 0x0001, 0x1991: 0x0005,
+	0x1992: 0x0001, 0x1993: 0x0005, 0x1994: 0x001c, 0x1995: 0x0001, 0x1996: 0x0001, 0x1997: 0x0001,
+	0x1998: 0x0001, 0x1999: 0x0001, 0x199a: 0x0001, 0x199b: 0x0001, 0x199c: 0x0001, 0x199d: 0x0001,
+	0x199e: 0x0001, 0x199f: 0x0001, 0x19a0: 0x0001, 0x19a1: 0x0001, 0x19a3: 0x0001,
+	0x19a8: 0x0001, 0x19a9: 0x0005,
+	0x19aa: 0x001c, 0x19ab: 0x0001, 0x19ac: 0x0001, 0x19ad: 0x0001, 0x19ae: 0x0001, 0x19af: 0x0001,
+	0x19b0: 0x0005, 0x19b1: 0x0005, 0x19b2: 0x001c, 0x19b3: 0x001c, 0x19b4: 0x0005, 0x19b5: 0x001c,
+	0x19b6: 0x0001, 0x19b7: 0x0005, 0x19b8: 0x0005, 0x19b9: 0x0005, 0x19ba: 0x001c, 0x19bb: 0x0001,
+	0x19bc: 0x0001, 0x19bd: 0x001c, 0x19be: 0x0001, 0x19bf: 0x0001,
+	// Block 0x67, offset 0x19c0
+	0x19c2: 0x0004, 0x19c5: 0x001c,
+	0x19c8: 0x0004, 0x19c9: 0x0004, 0x19ca: 0x001c, 0x19cb: 0x001c,
+	0x19cc: 0x0004, 0x19cd: 0x0004, 0x19cf: 0x0004,
+	0x19d2: 0x0004, 0x19d4: 0x0004, 0x19d6: 0x0004,
+	0x19dd: 0x0004,
+	0x19e1: 0x0004,
+	0x19e8: 0x001c,
+	0x19f3: 0x0004, 0x19f4: 0x0004,
+	0x19fd: 0x0001,
+	// Block 0x68, offset 0x1a00
+	0x1a04: 0x0004,
+	0x1a07: 0x0004,
+	0x1a0c: 0x001c, 0x1a0e: 0x001c,
+	0x1a13: 0x001c, 0x1a14: 0x001c, 0x1a15: 0x001c, 0x1a17: 0x001c,
+	0x1a23: 0x0004,
+	0x1a24: 0x0004,
+	0x1a36: 0x0001, 0x1a37: 0x0001, 0x1a38: 0x0001, 0x1a39: 0x0001, 0x1a3a: 0x0001, 0x1a3b: 0x0001,
+	0x1a3c: 0x0001, 0x1a3d: 0x0001, 0x1a3e: 0x0001, 0x1a3f: 0x0001,
+	// Block 0x69, offset 0x1a40
+	0x1a55: 0x001c, 0x1a56: 0x001c, 0x1a57: 0x001c,
+	0x1a61: 0x0004,
+	0x1a70: 0x001c,
+	0x1a7f: 0x001c,
+	// Block 0x6a, offset 0x1a80
+	0x1ab4: 0x0004, 0x1ab5: 0x0004,
+	// Block 0x6b, offset 0x1ac0
+	0x1ac5: 0x0004,
+	0x1ac6: 0x0004, 0x1ac7: 0x0004,
+	0x1adb: 0x001c, 0x1adc: 0x001c,
+	// Block 0x6c, offset 0x1b00
+	0x1b10: 0x001c,
+	0x1b15: 0x001c, 0x1b16: 0x0001, 0x1b17: 0x0001,
+	0x1b18: 0x0001, 0x1b19: 0x0001,
+	// Block 0x6d, offset 0x1b40
+	0x1b6f: 0x0020,
+	0x1b70: 0x0020, 0x1b71: 0x0020,
+	// Block 0x6e, offset 0x1b80
+	0x1bbf: 0x0020,
+	// Block 0x6f, offset 0x1bc0
+	0x1be0: 0x0020, 0x1be1: 0x0020, 0x1be2: 0x0020, 0x1be3: 0x0020,
+	0x1be4: 0x0020, 0x1be5: 0x0020, 0x1be6: 0x0020, 0x1be7: 0x0020, 0x1be8: 0x0020, 0x1be9: 0x0020,
+	0x1bea: 0x0020, 0x1beb: 0x0020, 0x1bec: 0x0020, 0x1bed: 0x0020, 0x1bee: 0x0020, 0x1bef: 0x0020,
+	0x1bf0: 0x0020, 0x1bf1: 0x0020, 0x1bf2: 0x0020, 0x1bf3: 0x0020, 0x1bf4: 0x0020, 0x1bf5: 0x0020,
+	0x1bf6: 0x0020, 0x1bf7: 0x0020, 0x1bf8: 0x0020, 0x1bf9: 0x0020, 0x1bfa: 0x0020, 0x1bfb: 0x0020,
+	0x1bfc: 0x0020, 0x1bfd: 0x0020, 0x1bfe: 0x0020, 0x1bff: 0x0020,
+	// Block 0x70, offset 0x1c00
+	0x1c00: 0x0010, 0x1c01: 0x0010, 0x1c02: 0x0010, 0x1c03: 0x0010, 0x1c04: 0x0010, 0x1c05: 0x0010,
+	0x1c06: 0x0010, 0x1c07: 0x0010, 0x1c08: 0x0010, 0x1c09: 0x0010, 0x1c0a: 0x0010, 0x1c0b: 0x0010,
+	0x1c0c: 0x0010, 0x1c0d: 0x0010, 0x1c0e: 0x0010, 0x1c0f: 0x0010, 0x1c10: 0x0010, 0x1c11: 0x0010,
+	0x1c12: 0x0010, 0x1c13: 0x0010, 0x1c14: 0x0010, 0x1c15: 0x0010, 0x1c16: 0x0010, 0x1c17: 0x0010,
+	0x1c18: 0x0010, 0x1c19: 0x0010, 0x1c1b: 0x0010, 0x1c1c: 0x0010, 0x1c1d: 0x0010,
+	0x1c1e: 0x0010, 0x1c1f: 0x0010, 0x1c20: 0x0010, 0x1c21: 0x0010, 0x1c22: 0x0010, 0x1c23: 0x0010,
+	0x1c24: 0x0010, 0x1c25: 0x0010, 0x1c26: 0x0010, 0x1c27: 0x0010, 0x1c28: 0x0010, 0x1c29: 0x0010,
+	0x1c2a: 0x0010, 0x1c2b: 0x0010, 0x1c2c: 0x0010, 0x1c2d: 0x0010, 0x1c2e: 0x0010, 0x1c2f: 0x0010,
+	0x1c30: 0x0010, 0x1c31: 0x0010, 0x1c32: 0x0010, 0x1c33: 0x0010, 0x1c34: 0x0010, 0x1c35: 0x0010,
+	0x1c36: 0x0010, 0x1c37: 0x0010, 0x1c38: 0x0010, 0x1c39: 0x0010, 0x1c3a: 0x0010, 0x1c3b: 0x0010,
+	0x1c3c: 0x0010, 0x1c3d: 0x0010, 0x1c3e: 0x0010, 0x1c3f: 0x0010,
+	// Block 0x71, offset 0x1c40
+	0x1c40: 0x0010, 0x1c41: 0x0010, 0x1c42: 0x0010, 0x1c43: 0x0010, 0x1c44: 0x0010, 0x1c45: 0x0010,
+	0x1c46: 0x0010, 0x1c47: 0x0010, 0x1c48: 0x0010, 0x1c49: 0x0010, 0x1c4a: 0x0010, 0x1c4b: 0x0010,
+	0x1c4c: 0x0010, 0x1c4d: 0x0010, 0x1c4e: 0x0010, 0x1c4f: 0x0010, 0x1c50: 0x0010, 0x1c51: 0x0010,
+	0x1c52: 0x0010, 0x1c53: 0x0010, 0x1c54: 0x0010, 0x1c55: 0x0010, 0x1c56: 0x0010, 0x1c57: 0x0010,
+	0x1c58: 0x0010, 0x1c59: 0x0010, 0x1c5a: 0x0010, 0x1c5b: 0x0010, 0x1c5c: 0x0010, 0x1c5d: 0x0010,
+	0x1c5e: 0x0010, 0x1c5f: 0x0010, 0x1c60: 0x0010, 0x1c61: 0x0010, 0x1c62: 0x0010, 0x1c63: 0x0010,
+	0x1c64: 0x0010, 0x1c65: 0x0010, 0x1c66: 0x0010, 0x1c67: 0x0010, 0x1c68: 0x0010, 0x1c69: 0x0010,
+	0x1c6a: 0x0010, 0x1c6b: 0x0010, 0x1c6c: 0x0010, 0x1c6d: 0x0010, 0x1c6e: 0x0010, 0x1c6f: 0x0010,
+	0x1c70: 0x0010, 0x1c71: 0x0010, 0x1c72: 0x0010, 0x1c73: 0x0010,
+	// Block 0x72, offset 0x1c80
+	0x1c80: 0x0010, 0x1c81: 0x0010, 0x1c82: 0x0010, 0x1c83: 0x0010, 0x1c84: 0x0010, 0x1c85: 0x0010,
+	0x1c86: 0x0010, 0x1c87: 0x0010, 0x1c88: 0x0010, 0x1c89: 0x0010, 0x1c8a: 0x0010, 0x1c8b: 0x0010,
+	0x1c8c: 0x0010, 0x1c8d: 0x0010, 0x1c8e: 0x0010, 0x1c8f: 0x0010, 0x1c90: 0x0010, 0x1c91: 0x0010,
+	0x1c92: 0x0010, 0x1c93: 0x0010, 0x1c94: 0x0010, 0x1c95: 0x0010,
+	0x1cb0: 0x0010, 0x1cb1: 0x0010, 0x1cb2: 0x0010, 0x1cb3: 0x0010, 0x1cb4: 0x0010, 0x1cb5: 0x0010,
+	0x1cb6: 0x0010, 0x1cb7: 0x0010, 0x1cb8: 0x0010, 0x1cb9: 0x0010, 0x1cba: 0x0010, 0x1cbb: 0x0010,
+	0x1cbc: 0x0010, 0x1cbd: 0x0010, 0x1cbe: 0x0010, 0x1cbf: 0x0010,
+	// Block 0x73, offset 0x1cc0
+	0x1cc0: 0x0010, 0x1cc1: 0x0010, 0x1cc2: 0x0010, 0x1cc3: 0x0010, 0x1cc4: 0x0010, 0x1cc5: 0x0010,
+	0x1cc6: 0x0010, 0x1cc7: 0x0010, 0x1cc8: 0x0010, 0x1cc9: 0x0010, 0x1cca: 0x0010, 0x1ccb: 0x0010,
+	0x1ccc: 0x0010, 0x1ccd: 0x0010, 0x1cce: 0x0010, 0x1ccf: 0x0010, 0x1cd0: 0x0010, 0x1cd1: 0x0010,
+	0x1cd2: 0x0010, 0x1cd3: 0x0010, 0x1cd4: 0x0010, 0x1cd5: 0x0010, 0x1cd6: 0x0010, 0x1cd7: 0x0010,
+	0x1cd8: 0x0010, 0x1cd9: 0x0010, 0x1cda: 0x0010, 0x1cdb: 0x0010, 0x1cdc: 0x0010, 0x1cdd: 0x0010,
+	0x1cde: 0x0010, 0x1cdf: 0x0010, 0x1ce0: 0x0010, 0x1ce1: 0x0010, 0x1ce2: 0x0010, 0x1ce3: 0x0010,
+	0x1ce4: 0x0010, 0x1ce5: 0x0010, 0x1ce6: 0x0010, 0x1ce7: 0x0010, 0x1ce8: 0x0010, 0x1ce9: 0x0010,
+	0x1cea: 0x0030, 0x1ceb: 0x0030, 0x1cec: 0x0030, 0x1ced: 0x0030, 0x1cee: 0x0010, 0x1cef: 0x0010,
+	0x1cf0: 0x0014, 0x1cf1: 0x0010, 0x1cf2: 0x0010, 0x1cf3: 0x0010, 0x1cf4: 0x0010, 0x1cf5: 0x0010,
+	0x1cf6: 0x0010, 0x1cf7: 0x0010, 0x1cf8: 0x0010, 0x1cf9: 0x0010, 0x1cfa: 0x0010, 0x1cfb: 0x0010,
+	0x1cfc: 0x0010, 0x1cfd: 0x0014, 0x1cfe: 0x0010,
+	// Block 0x74, offset 0x1d00
+	0x1d01: 0x0010, 0x1d02: 0x0010, 0x1d03: 0x0010, 0x1d04: 0x0010, 0x1d05: 0x0010,
+	0x1d06: 0x0010, 0x1d07: 0x0010, 0x1d08: 0x0010, 0x1d09: 0x0010, 0x1d0a: 0x0010, 0x1d0b: 0x0010,
+	0x1d0c: 0x0010, 0x1d0d: 0x0010, 0x1d0e: 0x0010, 0x1d0f: 0x0010, 0x1d10: 0x0010, 0x1d11: 0x0010,
+	0x1d12: 0x0010, 0x1d13: 0x0010, 0x1d14: 0x0010, 0x1d15: 0x0010, 0x1d16: 0x0010, 0x1d17: 0x0010,
+	0x1d18: 0x0010, 0x1d19: 0x0010, 0x1d1a: 0x0010, 0x1d1b: 0x0010, 0x1d1c: 0x0010, 0x1d1d: 0x0010,
+	0x1d1e: 0x0010, 0x1d1f: 0x0010, 0x1d20: 0x0010, 0x1d21: 0x0010, 0x1d22: 0x0010, 0x1d23: 0x0010,
+	0x1d24: 0x0010, 0x1d25: 0x0010, 0x1d26: 0x0010, 0x1d27: 0x0010, 0x1d28: 0x0010, 0x1d29: 0x0010,
+	0x1d2a: 0x0010, 0x1d2b: 0x0010, 0x1d2c: 0x0010, 0x1d2d: 0x0010, 0x1d2e: 0x0010, 0x1d2f: 0x0010,
+	0x1d30: 0x0010, 0x1d31: 0x0010, 0x1d32: 0x0010, 0x1d33: 0x0010, 0x1d34: 0x0010, 0x1d35: 0x0010,
+	0x1d36: 0x0010, 0x1d37: 0x0010, 0x1d38: 0x0010, 0x1d39: 0x0010, 0x1d3a: 0x0010, 0x1d3b: 0x0010,
+	0x1d3c: 0x0010, 0x1d3d: 0x0010, 0x1d3e: 0x0010, 0x1d3f: 0x0010,
+	// Block 0x75, offset 0x1d40
+	0x1d40: 0x0010, 0x1d41: 0x0010, 0x1d42: 0x0010, 0x1d43: 0x0010, 0x1d44: 0x0010, 0x1d45: 0x0010,
+	0x1d46: 0x0010, 0x1d47: 0x0010, 0x1d48: 0x0010, 0x1d49: 0x0010, 0x1d4a: 0x0010, 0x1d4b: 0x0010,
+	0x1d4c: 0x0010, 0x1d4d: 0x0010, 0x1d4e: 0x0010, 0x1d4f: 0x0010, 0x1d50: 0x0010, 0x1d51: 0x0010,
+	0x1d52: 0x0010, 0x1d53: 0x0010, 0x1d54: 0x0010, 0x1d55: 0x0010, 0x1d56: 0x0010,
+	0x1d59: 0x0030, 0x1d5a: 0x0030, 0x1d5b: 0x0010, 0x1d5c: 0x0010, 0x1d5d: 0x0010,
+	0x1d5e: 0x0010, 0x1d5f: 0x0010, 0x1d60: 0x0010, 0x1d61: 0x0010, 0x1d62: 0x0010, 0x1d63: 0x0010,
+	0x1d64: 0x0010, 0x1d65: 0x0010, 0x1d66: 0x0010, 0x1d67: 0x0010, 0x1d68: 0x0010, 0x1d69: 0x0010,
+	0x1d6a: 0x0010, 0x1d6b: 0x0010, 0x1d6c: 0x0010, 0x1d6d: 0x0010, 0x1d6e: 0x0010, 0x1d6f: 0x0010,
+	0x1d70: 0x0010, 0x1d71: 0x0010, 0x1d72: 0x0010, 0x1d73: 0x0010, 0x1d74: 0x0010, 0x1d75: 0x0010,
+	0x1d76: 0x0010, 0x1d77: 0x0010, 0x1d78: 0x0010, 0x1d79: 0x0010, 0x1d7a: 0x0010, 0x1d7b: 0x0010,
+	0x1d7c: 0x0010, 0x1d7d: 0x0010, 0x1d7e: 0x0010, 0x1d7f: 0x0010,
+	// Block 0x76, offset 0x1d80
+	0x1d85: 0x0010,
+	0x1d86: 0x0010, 0x1d87: 0x0010, 0x1d88: 0x0010, 0x1d89: 0x0010, 0x1d8a: 0x0010, 0x1d8b: 0x0010,
+	0x1d8c: 0x0010, 0x1d8d: 0x0010, 0x1d8e: 0x0010, 0x1d8f: 0x0010, 0x1d90: 0x0010, 0x1d91: 0x0010,
+	0x1d92: 0x0010, 0x1d93: 0x0010, 0x1d94: 0x0010, 0x1d95: 0x0010, 0x1d96: 0x0010, 0x1d97: 0x0010,
+	0x1d98: 0x0010, 0x1d99: 0x0010, 0x1d9a: 0x0010, 0x1d9b: 0x0010, 0x1d9c: 0x0010, 0x1d9d: 0x0010,
+	0x1d9e: 0x0010, 0x1d9f: 0x0010, 0x1da0: 0x0010, 0x1da1: 0x0010, 0x1da2: 0x0010, 0x1da3: 0x0010,
+	0x1da4: 0x0010, 0x1da5: 0x0010, 0x1da6: 0x0010, 0x1da7: 0x0010, 0x1da8: 0x0010, 0x1da9: 0x0010,
+	0x1daa: 0x0010, 0x1dab: 0x0010, 0x1dac: 0x0010, 0x1dad: 0x0010, 0x1dae: 0x0010, 0x1daf: 0x0010,
+	0x1db1: 0x0010, 0x1db2: 0x0010, 0x1db3: 0x0010, 0x1db4: 0x0010, 0x1db5: 0x0010,
+	0x1db6: 0x0010, 0x1db7: 0x0010, 0x1db8: 0x0010, 0x1db9: 0x0010, 0x1dba: 0x0010, 0x1dbb: 0x0010,
+	0x1dbc: 0x0010, 0x1dbd: 0x0010, 0x1dbe: 0x0010, 0x1dbf: 0x0010,
+	// Block 0x77, offset 0x1dc0
+	0x1dc0: 0x0010, 0x1dc1: 0x0010, 0x1dc2: 0x0010, 0x1dc3: 0x0010, 0x1dc4: 0x0010, 0x1dc5: 0x0010,
+	0x1dc6: 0x0010, 0x1dc7: 0x0010, 0x1dc8: 0x0010, 0x1dc9: 0x0010, 0x1dca: 0x0010, 0x1dcb: 0x0010,
+	0x1dcc: 0x0010, 0x1dcd: 0x0010, 0x1dce: 0x0010, 0x1dd0: 0x0010, 0x1dd1: 0x0010,
+	0x1dd2: 0x0010, 0x1dd3: 0x0010, 0x1dd4: 0x0010, 0x1dd5: 0x0010, 0x1dd6: 0x0010, 0x1dd7: 0x0010,
+	0x1dd8: 0x0010, 0x1dd9: 0x0010, 0x1dda: 0x0010, 0x1ddb: 0x0010, 0x1ddc: 0x0010, 0x1ddd: 0x0010,
+	0x1dde: 0x0010, 0x1ddf: 0x0010, 0x1de0: 0x0010, 0x1de1: 0x0010, 0x1de2: 0x0010, 0x1de3: 0x0010,
+	0x1de4: 0x0010, 0x1de5: 0x0010, 0x1de6: 0x0010, 0x1de7: 0x0010, 0x1de8: 0x0010, 0x1de9: 0x0010,
+	0x1dea: 0x0010, 0x1deb: 0x0010, 0x1dec: 0x0010, 0x1ded: 0x0010, 0x1dee: 0x0010, 0x1def: 0x0010,
+	0x1df0: 0x0010, 0x1df1: 0x0010, 0x1df2: 0x0010, 0x1df3: 0x0010, 0x1df4: 0x0010, 0x1df5: 0x0010,
+	0x1df6: 0x0010, 0x1df7: 0x0010, 0x1df8: 0x0010, 0x1df9: 0x0010, 0x1dfa: 0x0010, 0x1dfb: 0x0010,
+	0x1dfc: 0x0010, 0x1dfd: 0x0010, 0x1dfe: 0x0010, 0x1dff: 0x0010,
+	// Block 0x78, offset 0x1e00
+	0x1e00: 0x0010, 0x1e01: 0x0010, 0x1e02: 0x0010, 0x1e03: 0x0010, 0x1e04: 0x0010, 0x1e05: 0x0010,
+	0x1e06: 0x0010, 0x1e07: 0x0010, 0x1e08: 0x0010, 0x1e09: 0x0010, 0x1e0a: 0x0010, 0x1e0b: 0x0010,
+	0x1e0c: 0x0010, 0x1e0d: 0x0010, 0x1e0e: 0x0010, 0x1e0f: 0x0010, 0x1e10: 0x0010, 0x1e11: 0x0010,
+	0x1e12: 0x0010, 0x1e13: 0x0010, 0x1e14: 0x0010, 0x1e15: 0x0010, 0x1e16: 0x0010, 0x1e17: 0x0010,
+	0x1e18: 0x0010, 0x1e19: 0x0010, 0x1e1a: 0x0010, 0x1e1b: 0x0010, 0x1e1c: 0x0010, 0x1e1d: 0x0010,
+	0x1e1e: 0x0010, 0x1e1f: 0x0010, 0x1e20: 0x0010, 0x1e21: 0x0010, 0x1e22: 0x0010, 0x1e23: 0x0010,
+	0x1e24: 0x0010, 0x1e25: 0x0010,
+	0x1e2f: 0x0010,
+	0x1e30: 0x0010, 0x1e31: 0x0010, 0x1e32: 0x0010, 0x1e33: 0x0010, 0x1e34: 0x0010, 0x1e35: 0x0010,
+	0x1e36: 0x0010, 0x1e37: 0x0010, 0x1e38: 0x0010, 0x1e39: 0x0010, 0x1e3a: 0x0010, 0x1e3b: 0x0010,
+	0x1e3c: 0x0010, 0x1e3d: 0x0010, 0x1e3e: 0x0010, 0x1e3f: 0x0010,
+	// Block 0x79, offset 0x1e40
+	0x1e40: 0x0010, 0x1e41: 0x0010, 0x1e42: 0x0010, 0x1e43: 0x0010, 0x1e44: 0x0010, 0x1e45: 0x0010,
+	0x1e46: 0x0010, 0x1e47: 0x0010, 0x1e48: 0x0010, 0x1e49: 0x0010, 0x1e4a: 0x0010, 0x1e4b: 0x0010,
+	0x1e4c: 0x0010, 0x1e4d: 0x0010, 0x1e4e: 0x0010, 0x1e4f: 0x0010, 0x1e50: 0x0010, 0x1e51: 0x0010,
+	0x1e52: 0x0010, 0x1e53: 0x0010, 0x1e54: 0x0010, 0x1e55: 0x0010, 0x1e56: 0x0010, 0x1e57: 0x0010,
+	0x1e58: 0x0010, 0x1e59: 0x0010, 0x1e5a: 0x0010, 0x1e5b: 0x0010, 0x1e5c: 0x0010, 0x1e5d: 0x0010,
+	0x1e5e: 0x0010, 0x1e60: 0x0010, 0x1e61: 0x0010, 0x1e62: 0x0010, 0x1e63: 0x0010,
+	0x1e64: 0x0010, 0x1e65: 0x0010, 0x1e66: 0x0010, 0x1e67: 0x0010, 0x1e68: 0x0010, 0x1e69: 0x0010,
+	0x1e6a: 0x0010, 0x1e6b: 0x0010, 0x1e6c: 0x0010, 0x1e6d: 0x0010, 0x1e6e: 0x0010, 0x1e6f: 0x0010,
+	0x1e70: 0x0010, 0x1e71: 0x0010, 0x1e72: 0x0010, 0x1e73: 0x0010, 0x1e74: 0x0010, 0x1e75: 0x0010,
+	0x1e76: 0x0010, 0x1e77: 0x0010, 0x1e78: 0x0010, 0x1e79: 0x0010, 0x1e7a: 0x0010, 0x1e7b: 0x0010,
+	0x1e7c: 0x0010, 0x1e7d: 0x0010, 0x1e7e: 0x0010, 0x1e7f: 0x0010,
+	// Block 0x7a, offset 0x1e80
+	0x1e80: 0x0010, 0x1e81: 0x0010, 0x1e82: 0x0010, 0x1e83: 0x0010, 0x1e84: 0x0010, 0x1e85: 0x0010,
+	0x1e86: 0x0010, 0x1e87: 0x0010, 0x1e88: 0x0001, 0x1e89: 0x0001, 0x1e8a: 0x0001, 0x1e8b: 0x0001,
+	0x1e8c: 0x0001, 0x1e8d: 0x0001, 0x1e8e: 0x0001, 0x1e8f: 0x0001, 0x1e90: 0x0010, 0x1e91: 0x0010,
+	0x1e92: 0x0010, 0x1e93: 0x0010, 0x1e94: 0x0010, 0x1e95: 0x0010, 0x1e96: 0x0010, 0x1e97: 0x0010,
+	0x1e98: 0x0010, 0x1e99: 0x0010, 0x1e9a: 0x0010, 0x1e9b: 0x0010, 0x1e9c: 0x0010, 0x1e9d: 0x0010,
+	0x1e9e: 0x0010, 0x1e9f: 0x0010, 0x1ea0: 0x0010, 0x1ea1: 0x0010, 0x1ea2: 0x0010, 0x1ea3: 0x0010,
+	0x1ea4: 0x0010, 0x1ea5: 0x0010, 0x1ea6: 0x0010, 0x1ea7: 0x0010, 0x1ea8: 0x0010, 0x1ea9: 0x0010,
+	0x1eaa: 0x0010, 0x1eab: 0x0010, 0x1eac: 0x0010, 0x1ead: 0x0010, 0x1eae: 0x0010, 0x1eaf: 0x0010,
+	0x1eb0: 0x0010, 0x1eb1: 0x0010, 0x1eb2: 0x0010, 0x1eb3: 0x0010, 0x1eb4: 0x0010, 0x1eb5: 0x0010,
+	0x1eb6: 0x0010, 0x1eb7: 0x0010, 0x1eb8: 0x0010, 0x1eb9: 0x0010, 0x1eba: 0x0010, 0x1ebb: 0x0010,
+	0x1ebc: 0x0010, 0x1ebd: 0x0010, 0x1ebe: 0x0010, 0x1ebf: 0x0010,
+	// Block 0x7b, offset 0x1ec0
+	0x1ec0: 0x0010, 0x1ec1: 0x0010, 0x1ec2: 0x0010, 0x1ec3: 0x0010, 0x1ec4: 0x0010, 0x1ec5: 0x0010,
+	0x1ec6: 0x0010, 0x1ec7: 0x0010, 0x1ec8: 0x0010, 0x1ec9: 0x0010, 0x1eca: 0x0010, 0x1ecb: 0x0010,
+	0x1ecc: 0x0010, 0x1ecd: 0x0010, 0x1ece: 0x0010, 0x1ecf: 0x0010, 0x1ed0: 0x0010, 0x1ed1: 0x0010,
+	0x1ed2: 0x0010, 0x1ed3: 0x0010, 0x1ed4: 0x0010, 0x1ed5: 0x0010, 0x1ed6: 0x0010, 0x1ed7: 0x0014,
+	0x1ed8: 0x0010, 0x1ed9: 0x0014, 0x1eda: 0x0010, 0x1edb: 0x0010, 0x1edc: 0x0010, 0x1edd: 0x0010,
+	0x1ede: 0x0010, 0x1edf: 0x0010, 0x1ee0: 0x0010, 0x1ee1: 0x0010, 0x1ee2: 0x0010, 0x1ee3: 0x0010,
+	0x1ee4: 0x0010, 0x1ee5: 0x0010, 0x1ee6: 0x0010, 0x1ee7: 0x0010, 0x1ee8: 0x0010, 0x1ee9: 0x0010,
+	0x1eea: 0x0010, 0x1eeb: 0x0010, 0x1eec: 0x0010, 0x1eed: 0x0010, 0x1eee: 0x0010, 0x1eef: 0x0010,
+	0x1ef0: 0x0010, 0x1ef1: 0x0010, 0x1ef2: 0x0010, 0x1ef3: 0x0010, 0x1ef4: 0x0010, 0x1ef5: 0x0010,
+	0x1ef6: 0x0010, 0x1ef7: 0x0010, 0x1ef8: 0x0010, 0x1ef9: 0x0010, 0x1efa: 0x0010, 0x1efb: 0x0010,
+	0x1efc: 0x0010, 0x1efd: 0x0010, 0x1efe: 0x0010, 0x1eff: 0x0010,
+	// Block 0x7c, offset 0x1f00
+	0x1f00: 0x0010, 0x1f01: 0x0010, 0x1f02: 0x0010, 0x1f03: 0x0010, 0x1f04: 0x0010, 0x1f05: 0x0010,
+	0x1f06: 0x0010, 0x1f07: 0x0010, 0x1f08: 0x0010, 0x1f09: 0x0010, 0x1f0a: 0x0010, 0x1f0b: 0x0010,
+	0x1f0c: 0x0010, 0x1f10: 0x0010, 0x1f11: 0x0010,
+	0x1f12: 0x0010, 0x1f13: 0x0010, 0x1f14: 0x0010, 0x1f15: 0x0010, 0x1f16: 0x0010, 0x1f17: 0x0010,
+	0x1f18: 0x0010, 0x1f19: 0x0010, 0x1f1a: 0x0010, 0x1f1b: 0x0010, 0x1f1c: 0x0010, 0x1f1d: 0x0010,
+	0x1f1e: 0x0010, 0x1f1f: 0x0010, 0x1f20: 0x0010, 0x1f21: 0x0010, 0x1f22: 0x0010, 0x1f23: 0x0010,
+	0x1f24: 0x0010, 0x1f25: 0x0010, 0x1f26: 0x0010, 0x1f27: 0x0010, 0x1f28: 0x0010, 0x1f29: 0x0010,
+	0x1f2a: 0x0010, 0x1f2b: 0x0010, 0x1f2c: 0x0010, 0x1f2d: 0x0010, 0x1f2e: 0x0010, 0x1f2f: 0x0010,
+	0x1f30: 0x0010, 0x1f31: 0x0010, 0x1f32: 0x0010, 0x1f33: 0x0010, 0x1f34: 0x0010, 0x1f35: 0x0010,
+	0x1f36: 0x0010, 0x1f37: 0x0010, 0x1f38: 0x0010, 0x1f39: 0x0010, 0x1f3a: 0x0010, 0x1f3b: 0x0010,
+	0x1f3c: 0x0010, 0x1f3d: 0x0010, 0x1f3e: 0x0010, 0x1f3f: 0x0010,
+	// Block 0x7d, offset 0x1f40
+	0x1f40: 0x0010, 0x1f41: 0x0010, 0x1f42: 0x0010, 0x1f43: 0x0010, 0x1f44: 0x0010, 0x1f45: 0x0010,
+	0x1f46: 0x0010,
+	// Block 0x7e, offset 0x1f80
+	0x1faf: 0x0020,
+	0x1fb0: 0x0020, 0x1fb1: 0x0020, 0x1fb2: 0x0020, 0x1fb4: 0x0020, 0x1fb5: 0x0020,
+	0x1fb6: 0x0020, 0x1fb7: 0x0020, 0x1fb8: 0x0020, 0x1fb9: 0x0020, 0x1fba: 0x0020, 0x1fbb: 0x0020,
+	0x1fbc: 0x0020, 0x1fbd: 0x0020,
+	// Block 0x7f, offset 0x1fc0
+	0x1fde: 0x0020, 0x1fdf: 0x0020,
+	// Block 0x80, offset 0x2000
+	0x2030: 0x0020, 0x2031: 0x0020,
+	// Block 0x81, offset 0x2040
+	0x2042: 0x0020,
+	0x2046: 0x0020, 0x204b: 0x0020,
+	0x2065: 0x0020, 0x2066: 0x0020,
+	0x206c: 0x0020,
+	// Block 0x82, offset 0x2080
+	0x2084: 0x0020, 0x2085: 0x0020,
+	0x20a0: 0x0020, 0x20a1: 0x0020, 0x20a2: 0x0020, 0x20a3: 0x0020,
+	0x20a4: 0x0020, 0x20a5: 0x0020, 0x20a6: 0x0020, 0x20a7: 0x0020, 0x20a8: 0x0020, 0x20a9: 0x0020,
+	0x20aa: 0x0020, 0x20ab: 0x0020, 0x20ac: 0x0020, 0x20ad: 0x0020, 0x20ae: 0x0020, 0x20af: 0x0020,
+	0x20b0: 0x0020, 0x20b1: 0x0020,
+	0x20bf: 0x0020,
+	// Block 0x83, offset 0x20c0
+	0x20e6: 0x0020, 0x20e7: 0x0020, 0x20e8: 0x0020, 0x20e9: 0x0020,
+	0x20ea: 0x0020, 0x20eb: 0x0020, 0x20ec: 0x0020, 0x20ed: 0x0020,
+	// Block 0x84, offset 0x2100
+	0x2107: 0x0020, 0x2108: 0x0020, 0x2109: 0x0020, 0x210a: 0x0020, 0x210b: 0x0020,
+	0x210c: 0x0020, 0x210d: 0x0020, 0x210e: 0x0020, 0x210f: 0x0020, 0x2110: 0x0020, 0x2111: 0x0020,
+	0x2120: 0x0010, 0x2121: 0x0010, 0x2122: 0x0010, 0x2123: 0x0010,
+	0x2124: 0x0010, 0x2125: 0x0010, 0x2126: 0x0010, 0x2127: 0x0010, 0x2128: 0x0010, 0x2129: 0x0010,
+	0x212a: 0x0010, 0x212b: 0x0010, 0x212c: 0x0010, 0x212d: 0x0010, 0x212e: 0x0010, 0x212f: 0x0010,
+	0x2130: 0x0010, 0x2131: 0x0010, 0x2132: 0x0010, 0x2133: 0x0010, 0x2134: 0x0010, 0x2135: 0x0010,
+	0x2136: 0x0010, 0x2137: 0x0010, 0x2138: 0x0010, 0x2139: 0x0010, 0x213a: 0x0010, 0x213b: 0x0010,
+	0x213c: 0x0010,
+	// Block 0x85, offset 0x2140
+	0x2140: 0x0020, 0x2141: 0x0020, 0x2142: 0x0020,
+	0x2173: 0x0020,
+	0x2176: 0x0020, 0x2177: 0x0020, 0x2178: 0x0020, 0x2179: 0x0020,
+	0x217c: 0x0020, 0x217d: 0x0020,
+	// Block 0x86, offset 0x2180
+	0x21a5: 0x0020,
+	// Block 0x87, offset 0x21c0
+	0x21e9: 0x0020,
+	0x21ea: 0x0020, 0x21eb: 0x0020, 0x21ec: 0x0020, 0x21ed: 0x0020, 0x21ee: 0x0020,
+	0x21f1: 0x0020, 0x21f2: 0x0020, 0x21f5: 0x0020,
+	0x21f6: 0x0020,
+	// Block 0x88, offset 0x2200
+	0x2203: 0x0020,
+	0x220c: 0x0020,
+	0x223c: 0x0020,
+	// Block 0x89, offset 0x2240
+	0x2270: 0x0020, 0x2272: 0x0020, 0x2273: 0x0020, 0x2274: 0x0020,
+	0x2277: 0x0020, 0x2278: 0x0020,
+	0x227e: 0x0020, 0x227f: 0x0020,
+	// Block 0x8a, offset 0x2280
+	0x2281: 0x0020,
+	0x22ac: 0x0020, 0x22ad: 0x0020,
+	0x22b6: 0x0020,
+	// Block 0x8b, offset 0x22c0
+	0x22e5: 0x0020, 0x22e8: 0x0020,
+	0x22ed: 0x0020,
+	// Block 0x8c, offset 0x2300
+	0x2300: 0x0010, 0x2301: 0x0010, 0x2302: 0x0010, 0x2303: 0x0010, 0x2304: 0x0010, 0x2305: 0x0010,
+	0x2306: 0x0010, 0x2307: 0x0010, 0x2308: 0x0010, 0x2309: 0x0010, 0x230a: 0x0010, 0x230b: 0x0010,
+	0x230c: 0x0010, 0x230d: 0x0010, 0x230e: 0x0010, 0x230f: 0x0010, 0x2310: 0x0010, 0x2311: 0x0010,
+	0x2312: 0x0010, 0x2313: 0x0010, 0x2314: 0x0010, 0x2315: 0x0010, 0x2316: 0x0010, 0x2317: 0x0010,
+	0x2318: 0x0010, 0x2319: 0x0010, 0x231a: 0x0010, 0x231b: 0x0010, 0x231c: 0x0010, 0x231d: 0x0010,
+	0x231e: 0x0010, 0x231f: 0x0010, 0x2320: 0x0010, 0x2321: 0x0010, 0x2322: 0x0010, 0x2323: 0x0010,
+	0x2330: 0x0020, 0x2331: 0x0020, 0x2332: 0x0020, 0x2333: 0x0020, 0x2334: 0x0020, 0x2335: 0x0020,
+	0x2336: 0x0020, 0x2337: 0x0020, 0x2338: 0x0020, 0x2339: 0x0020, 0x233a: 0x0020, 0x233b: 0x0020,
+	0x233c: 0x0020, 0x233d: 0x0020, 0x233e: 0x0020, 0x233f: 0x0020,
+	// Block 0x8d, offset 0x2340
+	0x2340: 0x0020, 0x2341: 0x0020, 0x2342: 0x0020, 0x2343: 0x0020, 0x2344: 0x0020, 0x2345: 0x0020,
+	0x2346: 0x0020, 0x234b: 0x0020,
+	0x234c: 0x0020, 0x234d: 0x0020, 0x234e: 0x0020, 0x234f: 0x0020, 0x2350: 0x0020, 0x2351: 0x0020,
+	0x2352: 0x0020, 0x2353: 0x0020, 0x2354: 0x0020, 0x2355: 0x0020, 0x2356: 0x0020, 0x2357: 0x0020,
+	0x2358: 0x0020, 0x2359: 0x0020, 0x235a: 0x0020, 0x235b: 0x0020, 0x235c: 0x0020, 0x235d: 0x0020,
+	0x235e: 0x0020, 0x235f: 0x0020, 0x2360: 0x0020, 0x2361: 0x0020, 0x2362: 0x0020, 0x2363: 0x0020,
+	0x2364: 0x0020, 0x2365: 0x0020, 0x2366: 0x0020, 0x2367: 0x0020, 0x2368: 0x0020, 0x2369: 0x0020,
+	0x236a: 0x0020, 0x236b: 0x0020, 0x236c: 0x0020, 0x236d: 0x0020, 0x236e: 0x0020, 0x236f: 0x0020,
+	0x2370: 0x0020, 0x2371: 0x0020, 0x2372: 0x0020, 0x2373: 0x0020, 0x2374: 0x0020, 0x2375: 0x0020,
+	0x2376: 0x0020, 0x2377: 0x0020, 0x2378: 0x0020, 0x2379: 0x0020, 0x237a: 0x0020, 0x237b: 0x0020,
+	// Block 0x8e, offset 0x2380
+	0x239e: 0x0020,
+	// Block 0x8f, offset 0x23c0
+	0x23c0: 0x0021, 0x23c1: 0x0021, 0x23c2: 0x0021, 0x23c3: 0x0021, 0x23c4: 0x0021, 0x23c5: 0x0021,
+	0x23c6: 0x0021, 0x23c7: 0x0021, 0x23c8: 0x0021, 0x23c9: 0x0021, 0x23ca: 0x0021, 0x23cb: 0x0021,
+	0x23cc: 0x0021, 0x23cd: 0x0021, 0x23ce: 0x0021, 0x23cf: 0x0021, 0x23d0: 0x0010, 0x23d1: 0x0010,
+	0x23d2: 0x0010, 0x23d3: 0x0010, 0x23d4: 0x0010, 0x23d5: 0x0010, 0x23d6: 0x0010, 0x23d7: 0x0010,
+	0x23d8: 0x0010, 0x23d9: 0x0010,
+	0x23e0: 0x0020, 0x23e1: 0x0020, 0x23e2: 0x0020, 0x23e3: 0x0020,
+	0x23e4: 0x0020, 0x23e5: 0x0020, 0x23e6: 0x0020, 0x23e7: 0x0020, 0x23e8: 0x0020, 0x23e9: 0x0020,
+	0x23ea: 0x0020, 0x23eb: 0x0020, 0x23ec: 0x0020, 0x23ed: 0x0020, 0x23ee: 0x0020, 0x23ef: 0x0020,
+	0x23f0: 0x0010, 0x23f1: 0x0010, 0x23f2: 0x0010, 0x23f3: 0x0010, 0x23f4: 0x0010, 0x23f5: 0x0010,
+	0x23f6: 0x0010, 0x23f7: 0x0010, 0x23f8: 0x0010, 0x23f9: 0x0010, 0x23fa: 0x0010, 0x23fb: 0x0010,
+	0x23fc: 0x0010, 0x23fd: 0x0010, 0x23fe: 0x0010, 0x23ff: 0x0010,
+	// Block 0x90, offset 0x2400
+	0x2400: 0x0010, 0x2401: 0x0010, 0x2402: 0x0010, 0x2403: 0x0010, 0x2404: 0x0010, 0x2405: 0x0010,
+	0x2406: 0x0010, 0x2407: 0x0010, 0x2408: 0x0010, 0x2409: 0x0010, 0x240a: 0x0010, 0x240b: 0x0010,
+	0x240c: 0x0010, 0x240d: 0x0010, 0x240e: 0x0010, 0x240f: 0x0010, 0x2410: 0x0010, 0x2411: 0x0010,
+	0x2412: 0x0010, 0x2414: 0x0010, 0x2415: 0x0010, 0x2416: 0x0010, 0x2417: 0x0010,
+	0x2418: 0x0010, 0x2419: 0x0010, 0x241a: 0x0010, 0x241b: 0x0010, 0x241c: 0x0010, 0x241d: 0x0010,
+	0x241e: 0x0010, 0x241f: 0x0010, 0x2420: 0x0010, 0x2421: 0x0010, 0x2422: 0x0010, 0x2423: 0x0010,
+	0x2424: 0x0010, 0x2425: 0x0010, 0x2426: 0x0010, 0x2428: 0x0010, 0x2429: 0x0010,
+	0x242a: 0x0010, 0x242b: 0x0010,
+	// Block 0x91, offset 0x2440
+	0x2440: 0x0010, 0x2441: 0x0010, 0x2442: 0x0010, 0x2443: 0x0010, 0x2444: 0x0010, 0x2445: 0x0010,
+	0x2446: 0x0010, 0x2447: 0x0010, 0x2448: 0x0010, 0x2449: 0x0010, 0x244a: 0x0010, 0x244b: 0x0010,
+	0x244c: 0x0010, 0x244d: 0x0010, 0x244e: 0x0010, 0x244f: 0x0010, 0x2450: 0x0010, 0x2451: 0x0010,
+	0x2452: 0x0010, 0x2453: 0x0010, 0x2454: 0x0010, 0x2455: 0x0010, 0x2456: 0x0010, 0x2457: 0x0010,
+	0x2458: 0x0010, 0x2459: 0x0010, 0x245a: 0x0010, 0x245b: 0x0010, 0x245c: 0x0010, 0x245d: 0x0010,
+	0x245e: 0x0010, 0x245f: 0x0010, 0x2460: 0x0010,
+	// Block 0x92, offset 0x2480
+	0x24a0: 0x0010, 0x24a1: 0x0010, 0x24a2: 0x0010, 0x24a3: 0x0010,
+	0x24a4: 0x0010, 0x24a5: 0x0010, 0x24a6: 0x0010,
+	0x24b9: 0x0020, 0x24ba: 0x0020, 0x24bb: 0x0020,
+	0x24bd: 0x0001,
+	// Block 0x93, offset 0x24c0
+	0x24fd: 0x0020,
+	// Block 0x94, offset 0x2500
+	0x2520: 0x0020,
+	// Block 0x95, offset 0x2540
+	0x2576: 0x0020, 0x2577: 0x0020, 0x2578: 0x0020, 0x2579: 0x0020, 0x257a: 0x0020,
+	// Block 0x96, offset 0x2580
+	0x2581: 0x0020, 0x2582: 0x0020, 0x2583: 0x0020, 0x2585: 0x0020,
+	0x2586: 0x0020,
+	0x258c: 0x0020, 0x258d: 0x0020, 0x258e: 0x0020, 0x258f: 0x0020,
+	0x25b8: 0x0020, 0x25b9: 0x0020, 0x25ba: 0x0020,
+	0x25bf: 0x0020,
+	// Block 0x97, offset 0x25c0
+	0x25e5: 0x0020, 0x25e6: 0x0020,
+	// Block 0x98, offset 0x2600
+	0x2624: 0x0020, 0x2625: 0x0020, 0x2626: 0x0020, 0x2627: 0x0020,
+	// Block 0x99, offset 0x2640
+	0x2669: 0x0020,
+	0x266a: 0x0020, 0x266b: 0x0020, 0x266c: 0x0020, 0x266d: 0x0020,
+	// Block 0x9a, offset 0x2680
+	0x26ab: 0x0020, 0x26ac: 0x0020,
+	// Block 0x9b, offset 0x26c0
+	0x26fa: 0x0020, 0x26fb: 0x0020,
+	0x26fc: 0x0020, 0x26fd: 0x0020, 0x26fe: 0x0020, 0x26ff: 0x0020,
+	// Block 0x9c, offset 0x2700
+	0x2706: 0x0020, 0x2707: 0x0020, 0x2708: 0x0020, 0x2709: 0x0020, 0x270a: 0x0020, 0x270b: 0x0020,
+	0x270c: 0x0020, 0x270d: 0x0020, 0x270e: 0x0020, 0x270f: 0x0020, 0x2710: 0x0020,
+	// Block 0x9d, offset 0x2740
+	0x2742: 0x0020, 0x2743: 0x0020, 0x2744: 0x0020, 0x2745: 0x0020,
+	// Block 0x9e, offset 0x2780
+	0x2781: 0x0020,
+	0x27b8: 0x0020, 0x27b9: 0x0020, 0x27ba: 0x0020, 0x27bb: 0x0020,
+	0x27bc: 0x0020, 0x27bd: 0x0020, 0x27be: 0x0020, 0x27bf: 0x0020,
+	// Block 0x9f, offset 0x27c0
+	0x27c0: 0x0020, 0x27c1: 0x0020, 0x27c2: 0x0020, 0x27c3: 0x0020, 0x27c4: 0x0020, 0x27c5: 0x0020,
+	0x27c6: 0x0020,
+	0x27f0: 0x0020, 0x27f3: 0x0020, 0x27f4: 0x0020,
+	0x27ff: 0x0020,
+	// Block 0xa0, offset 0x2800
+	0x2800: 0x0020, 0x2801: 0x0020,
+	0x2833: 0x0020, 0x2834: 0x0020, 0x2835: 0x0020,
+	0x2836: 0x0020, 0x2839: 0x0020, 0x283a: 0x0020,
+	0x283d: 0x0020,
+	// Block 0xa1, offset 0x2840
+	0x2842: 0x0020,
+	0x284d: 0x0020,
+	// Block 0xa2, offset 0x2880
+	0x2880: 0x0020, 0x2881: 0x0020, 0x2882: 0x0020,
+	0x28a7: 0x0020, 0x28a8: 0x0020, 0x28a9: 0x0020,
+	0x28aa: 0x0020, 0x28ab: 0x0020, 0x28ad: 0x0020, 0x28ae: 0x0020, 0x28af: 0x0020,
+	0x28b0: 0x0020, 0x28b1: 0x0020, 0x28b2: 0x0020, 0x28b3: 0x0020, 0x28b4: 0x0020,
+	// Block 0xa3, offset 0x28c0
+	0x28f3: 0x0020,
+	// Block 0xa4, offset 0x2900
+	0x2900: 0x0020, 0x2901: 0x0020,
+	0x2936: 0x0020, 0x2937: 0x0020, 0x2938: 0x0020, 0x2939: 0x0020, 0x293a: 0x0020, 0x293b: 0x0020,
+	0x293c: 0x0020, 0x293d: 0x0020, 0x293e: 0x0020,
+	// Block 0xa5, offset 0x2940
+	0x2949: 0x0020, 0x294a: 0x0020, 0x294b: 0x0020,
+	0x294c: 0x0020, 0x294f: 0x0020,
+	// Block 0xa6, offset 0x2980
+	0x29af: 0x0020,
+	0x29b0: 0x0020, 0x29b1: 0x0020, 0x29b4: 0x0020,
+	0x29b6: 0x0020, 0x29b7: 0x0020,
+	0x29be: 0x0020,
+	// Block 0xa7, offset 0x29c0
+	0x29df: 0x0020, 0x29e3: 0x0020,
+	0x29e4: 0x0020, 0x29e5: 0x0020, 0x29e6: 0x0020, 0x29e7: 0x0020, 0x29e8: 0x0020, 0x29e9: 0x0020,
+	0x29ea: 0x0020,
+	// Block 0xa8, offset 0x2a00
+	0x2a00: 0x0020,
+	0x2a26: 0x0020, 0x2a27: 0x0020, 0x2a28: 0x0020, 0x2a29: 0x0020,
+	0x2a2a: 0x0020, 0x2a2b: 0x0020, 0x2a2c: 0x0020,
+	0x2a30: 0x0020, 0x2a31: 0x0020, 0x2a32: 0x0020, 0x2a33: 0x0020, 0x2a34: 0x0020,
+	// Block 0xa9, offset 0x2a40
+	0x2a7b: 0x0020,
+	0x2a7c: 0x0020, 0x2a7d: 0x0020, 0x2a7e: 0x0020, 0x2a7f: 0x0020,
+	// Block 0xaa, offset 0x2a80
+	0x2a80: 0x0020,
+	0x2a8e: 0x0020, 0x2a90: 0x0020,
+	0x2a92: 0x0020,
+	0x2aa1: 0x0020, 0x2aa2: 0x0020,
+	// Block 0xab, offset 0x2ac0
+	0x2af8: 0x0020, 0x2af9: 0x0020, 0x2afa: 0x0020, 0x2afb: 0x0020,
+	0x2afc: 0x0020, 0x2afd: 0x0020, 0x2afe: 0x0020, 0x2aff: 0x0020,
+	// Block 0xac, offset 0x2b00
+	0x2b02: 0x0020, 0x2b03: 0x0020, 0x2b04: 0x0020,
+	0x2b06: 0x0020,
+	0x2b1e: 0x0020,
+	// Block 0xad, offset 0x2b40
+	0x2b73: 0x0020, 0x2b74: 0x0020, 0x2b75: 0x0020,
+	0x2b76: 0x0020, 0x2b77: 0x0020, 0x2b78: 0x0020, 0x2b7a: 0x0020,
+	0x2b7f: 0x0020,
+	// Block 0xae, offset 0x2b80
+	0x2b80: 0x0020, 0x2b82: 0x0020, 0x2b83: 0x0020,
+	// Block 0xaf, offset 0x2bc0
+	0x2bf2: 0x0020, 0x2bf3: 0x0020, 0x2bf4: 0x0020, 0x2bf5: 0x0020,
+	0x2bfc: 0x0020, 0x2bfd: 0x0020, 0x2bff: 0x0020,
+	// Block 0xb0, offset 0x2c00
+	0x2c00: 0x0020,
+	0x2c1c: 0x0020, 0x2c1d: 0x0020,
+	// Block 0xb1, offset 0x2c40
+	0x2c73: 0x0020, 0x2c74: 0x0020, 0x2c75: 0x0020,
+	0x2c76: 0x0020, 0x2c77: 0x0020, 0x2c78: 0x0020, 0x2c79: 0x0020, 0x2c7a: 0x0020,
+	0x2c7d: 0x0020, 0x2c7f: 0x0020,
+	// Block 0xb2, offset 0x2c80
+	0x2c80: 0x0020,
+	// Block 0xb3, offset 0x2cc0
+	0x2ceb: 0x0020, 0x2ced: 0x0020,
+	0x2cf0: 0x0020, 0x2cf1: 0x0020, 0x2cf2: 0x0020, 0x2cf3: 0x0020, 0x2cf4: 0x0020, 0x2cf5: 0x0020,
+	0x2cf7: 0x0020,
+	// Block 0xb4, offset 0x2d00
+	0x2d1d: 0x0020,
+	0x2d1f: 0x0020, 0x2d22: 0x0020, 0x2d23: 0x0020,
+	0x2d24: 0x0020, 0x2d25: 0x0020, 0x2d27: 0x0020, 0x2d28: 0x0020, 0x2d29: 0x0020,
+	0x2d2a: 0x0020, 0x2d2b: 0x0020,
+	// Block 0xb5, offset 0x2d40
+	0x2d6f: 0x0020,
+	0x2d70: 0x0020, 0x2d71: 0x0020, 0x2d72: 0x0020, 0x2d73: 0x0020, 0x2d74: 0x0020, 0x2d75: 0x0020,
+	0x2d76: 0x0020, 0x2d77: 0x0020, 0x2d79: 0x0020, 0x2d7a: 0x0020,
+	// Block 0xb6, offset 0x2d80
+	0x2dbb: 0x0020,
+	0x2dbc: 0x0020, 0x2dbe: 0x0020,
+	// Block 0xb7, offset 0x2dc0
+	0x2dc3: 0x0020,
+	// Block 0xb8, offset 0x2e00
+	0x2e14: 0x0020, 0x2e15: 0x0020, 0x2e16: 0x0020, 0x2e17: 0x0020,
+	0x2e1a: 0x0020, 0x2e1b: 0x0020,
+	0x2e20: 0x0020,
+	// Block 0xb9, offset 0x2e40
+	0x2e41: 0x0020, 0x2e42: 0x0020, 0x2e43: 0x0020, 0x2e44: 0x0020, 0x2e45: 0x0020,
+	0x2e46: 0x0020, 0x2e47: 0x0020, 0x2e48: 0x0020, 0x2e49: 0x0020, 0x2e4a: 0x0020,
+	0x2e73: 0x0020, 0x2e74: 0x0020, 0x2e75: 0x0020,
+	0x2e76: 0x0020, 0x2e77: 0x0020, 0x2e78: 0x0020, 0x2e7b: 0x0020,
+	0x2e7c: 0x0020, 0x2e7d: 0x0020, 0x2e7e: 0x0020,
+	// Block 0xba, offset 0x2e80
+	0x2e87: 0x0020,
+	0x2e91: 0x0020,
+	0x2e92: 0x0020, 0x2e93: 0x0020, 0x2e94: 0x0020, 0x2e95: 0x0020, 0x2e96: 0x0020,
+	0x2e99: 0x0020, 0x2e9a: 0x0020, 0x2e9b: 0x0020,
+	// Block 0xbb, offset 0x2ec0
+	0x2eca: 0x0020, 0x2ecb: 0x0020,
+	0x2ecc: 0x0020, 0x2ecd: 0x0020, 0x2ece: 0x0020, 0x2ecf: 0x0020, 0x2ed0: 0x0020, 0x2ed1: 0x0020,
+	0x2ed2: 0x0020, 0x2ed3: 0x0020, 0x2ed4: 0x0020, 0x2ed5: 0x0020, 0x2ed6: 0x0020,
+	0x2ed8: 0x0020, 0x2ed9: 0x0020,
+	// Block 0xbc, offset 0x2f00
+	0x2f20: 0x0020, 0x2f22: 0x0020, 0x2f23: 0x0020,
+	0x2f24: 0x0020, 0x2f26: 0x0020,
+	// Block 0xbd, offset 0x2f40
+	0x2f70: 0x0020, 0x2f71: 0x0020, 0x2f72: 0x0020, 0x2f73: 0x0020, 0x2f74: 0x0020, 0x2f75: 0x0020,
+	0x2f76: 0x0020, 0x2f78: 0x0020, 0x2f79: 0x0020, 0x2f7a: 0x0020, 0x2f7b: 0x0020,
+	0x2f7c: 0x0020, 0x2f7d: 0x0020, 0x2f7f: 0x0020,
+	// Block 0xbe, offset 0x2f80
+	0x2f92: 0x0020, 0x2f93: 0x0020, 0x2f94: 0x0020, 0x2f95: 0x0020, 0x2f96: 0x0020, 0x2f97: 0x0020,
+	0x2f98: 0x0020, 0x2f99: 0x0020, 0x2f9a: 0x0020, 0x2f9b: 0x0020, 0x2f9c: 0x0020, 0x2f9d: 0x0020,
+	0x2f9e: 0x0020, 0x2f9f: 0x0020, 0x2fa0: 0x0020, 0x2fa1: 0x0020, 0x2fa2: 0x0020, 0x2fa3: 0x0020,
+	0x2fa4: 0x0020, 0x2fa5: 0x0020, 0x2fa6: 0x0020, 0x2fa7: 0x0020,
+	0x2faa: 0x0020, 0x2fab: 0x0020, 0x2fac: 0x0020, 0x2fad: 0x0020, 0x2fae: 0x0020, 0x2faf: 0x0020,
+	0x2fb0: 0x0020, 0x2fb2: 0x0020, 0x2fb3: 0x0020, 0x2fb5: 0x0020,
+	0x2fb6: 0x0020,
+	// Block 0xbf, offset 0x2fc0
+	0x2ff1: 0x0020, 0x2ff2: 0x0020, 0x2ff3: 0x0020, 0x2ff4: 0x0020, 0x2ff5: 0x0020,
+	0x2ff6: 0x0020, 0x2ffa: 0x0020,
+	0x2ffc: 0x0020, 0x2ffd: 0x0020, 0x2fff: 0x0020,
+	// Block 0xc0, offset 0x3000
+	0x3000: 0x0020, 0x3001: 0x0020, 0x3002: 0x0020, 0x3003: 0x0020, 0x3004: 0x0020, 0x3005: 0x0020,
+	0x3007: 0x0020,
+	// Block 0xc1, offset 0x3040
+	0x3050: 0x0020, 0x3051: 0x0020,
+	0x3055: 0x0020, 0x3057: 0x0020,
+	// Block 0xc2, offset 0x3080
+	0x30b3: 0x0020, 0x30b4: 0x0020,
+	// Block 0xc3, offset 0x30c0
+	0x30c0: 0x0020, 0x30c1: 0x0020,
+	0x30f6: 0x0020, 0x30f7: 0x0020, 0x30f8: 0x0020, 0x30f9: 0x0020, 0x30fa: 0x0020,
+	// Block 0xc4, offset 0x3100
+	0x3100: 0x0020, 0x3102: 0x0020,
+	0x311a: 0x0020,
+	// Block 0xc5, offset 0x3140
+	0x3140: 0x0020,
+	0x3147: 0x0020, 0x3148: 0x0020, 0x3149: 0x0020, 0x314a: 0x0020, 0x314b: 0x0020,
+	0x314c: 0x0020, 0x314d: 0x0020, 0x314e: 0x0020, 0x314f: 0x0020, 0x3150: 0x0020, 0x3151: 0x0020,
+	0x3152: 0x0020, 0x3153: 0x0020, 0x3154: 0x0020, 0x3155: 0x0020,
+	// Block 0xc6, offset 0x3180
+	0x319e: 0x0020, 0x319f: 0x0020, 0x31a0: 0x0020, 0x31a1: 0x0020, 0x31a2: 0x0020, 0x31a3: 0x0020,
+	0x31a4: 0x0020, 0x31a5: 0x0020, 0x31a6: 0x0020, 0x31a7: 0x0020, 0x31a8: 0x0020, 0x31a9: 0x0020,
+	0x31ad: 0x0020, 0x31ae: 0x0020, 0x31af: 0x0020,
+	// Block 0xc7, offset 0x31c0
+	0x31f0: 0x0020, 0x31f1: 0x0020, 0x31f2: 0x0020, 0x31f3: 0x0020, 0x31f4: 0x0020,
+	// Block 0xc8, offset 0x3200
+	0x3230: 0x0020, 0x3231: 0x0020, 0x3232: 0x0020, 0x3233: 0x0020, 0x3234: 0x0020, 0x3235: 0x0020,
+	0x3236: 0x0020,
+	// Block 0xc9, offset 0x3240
+	0x324f: 0x0020,
+	// Block 0xca, offset 0x3280
+	0x328f: 0x0020, 0x3290: 0x0020, 0x3291: 0x0020,
+	0x3292: 0x0020,
+	// Block 0xcb, offset 0x32c0
+	0x32e0: 0x0010, 0x32e1: 0x0010, 0x32e2: 0x0010, 0x32e3: 0x0010,
+	0x32e4: 0x0030,
+	0x32f0: 0x0010, 0x32f1: 0x0010, 0x32f2: 0x0010, 0x32f3: 0x0010, 0x32f4: 0x0010, 0x32f5: 0x0010,
+	0x32f6: 0x0010,
+	// Block 0xcc, offset 0x3300
+	0x3300: 0x0010, 0x3301: 0x0010, 0x3302: 0x0010, 0x3303: 0x0010, 0x3304: 0x0010, 0x3305: 0x0010,
+	0x3306: 0x0010, 0x3307: 0x0010, 0x3308: 0x0010, 0x3309: 0x0010, 0x330a: 0x0010, 0x330b: 0x0010,
+	0x330c: 0x0010, 0x330d: 0x0010, 0x330e: 0x0010, 0x330f: 0x0010, 0x3310: 0x0010, 0x3311: 0x0010,
+	0x3312: 0x0010, 0x3313: 0x0010, 0x3314: 0x0010, 0x3315: 0x0010,
+	0x333f: 0x0010,
+	// Block 0xcd, offset 0x3340
+	0x3340: 0x0010, 0x3341: 0x0010, 0x3342: 0x0010, 0x3343: 0x0010, 0x3344: 0x0010, 0x3345: 0x0010,
+	0x3346: 0x0010, 0x3347: 0x0010, 0x3348: 0x0010, 0x3349: 0x0010, 0x334a: 0x0010, 0x334b: 0x0010,
+	0x334c: 0x0010, 0x334d: 0x0010, 0x334e: 0x0010, 0x334f: 0x0010, 0x3350: 0x0010, 0x3351: 0x0010,
+	0x3352: 0x0010, 0x3353: 0x0010, 0x3354: 0x0010, 0x3355: 0x0010, 0x3356: 0x0010, 0x3357: 0x0010,
+	0x3358: 0x0010, 0x3359: 0x0010, 0x335a: 0x0010, 0x335b: 0x0010, 0x335c: 0x0010, 0x335d: 0x0010,
+	0x335e: 0x0010,
+	// Block 0xce, offset 0x3380
+	0x3380: 0x0010, 0x3381: 0x0010, 0x3382: 0x0010, 0x3383: 0x0010, 0x3384: 0x0010, 0x3385: 0x0010,
+	0x3386: 0x0010, 0x3387: 0x0010, 0x3388: 0x0010, 0x3389: 0x0010, 0x338a: 0x0010, 0x338b: 0x0010,
+	0x338c: 0x0010, 0x338d: 0x0010, 0x338e: 0x0010, 0x338f: 0x0010, 0x3390: 0x0010, 0x3391: 0x0010,
+	0x3392: 0x0010, 0x3393: 0x0010, 0x3394: 0x0010, 0x3395: 0x0010, 0x3396: 0x0010, 0x3397: 0x0010,
+	0x3398: 0x0010, 0x3399: 0x0010, 0x339a: 0x0010, 0x339b: 0x0010, 0x339c: 0x0010, 0x339d: 0x0010,
+	0x339e: 0x0010, 0x339f: 0x0010, 0x33a0: 0x0010, 0x33a1: 0x0010, 0x33a2: 0x0010, 0x33a3: 0x0010,
+	0x33a4: 0x0010, 0x33a5: 0x0010, 0x33a6: 0x0010, 0x33a7: 0x0010, 0x33a8: 0x0010, 0x33a9: 0x0010,
+	0x33aa: 0x0010, 0x33ab: 0x0010, 0x33ac: 0x0010, 0x33ad: 0x0010, 0x33ae: 0x0010, 0x33af: 0x0010,
+	0x33b0: 0x0010, 0x33b1: 0x0010, 0x33b2: 0x0010,
+	// Block 0xcf, offset 0x33c0
+	0x33f0: 0x0010, 0x33f1: 0x0010, 0x33f2: 0x0010, 0x33f3: 0x0010, 0x33f5: 0x0010,
+	0x33f6: 0x0010, 0x33f7: 0x0010, 0x33f8: 0x0010, 0x33f9: 0x0010, 0x33fa: 0x0010, 0x33fb: 0x0010,
+	0x33fd: 0x0010, 0x33fe: 0x0010,
+	// Block 0xd0, offset 0x3400
+	0x3400: 0x0010, 0x3401: 0x0010, 0x3402: 0x0010, 0x3403: 0x0010, 0x3404: 0x0010, 0x3405: 0x0010,
+	0x3406: 0x0010, 0x3407: 0x0010, 0x3408: 0x0010, 0x3409: 0x0010, 0x340a: 0x0010, 0x340b: 0x0010,
+	0x340c: 0x0010, 0x340d: 0x0010, 0x340e: 0x0010, 0x340f: 0x0010, 0x3410: 0x0010, 0x3411: 0x0010,
+	0x3412: 0x0010, 0x3413: 0x0010, 0x3414: 0x0010, 0x3415: 0x0010, 0x3416: 0x0010, 0x3417: 0x0010,
+	0x3418: 0x0010, 0x3419: 0x0010, 0x341a: 0x0010, 0x341b: 0x0010, 0x341c: 0x0010, 0x341d: 0x0010,
+	0x341e: 0x0010, 0x341f: 0x0010, 0x3420: 0x0010, 0x3421: 0x0010, 0x3422: 0x0010,
+	0x3432: 0x0010,
+	// Block 0xd1, offset 0x3440
+	0x3450: 0x0010, 0x3451: 0x0010,
+	0x3452: 0x0010, 0x3455: 0x0010,
+	0x3464: 0x0010, 0x3465: 0x0010, 0x3466: 0x0010, 0x3467: 0x0010,
+	0x3470: 0x0010, 0x3471: 0x0010, 0x3472: 0x0010, 0x3473: 0x0010, 0x3474: 0x0010, 0x3475: 0x0010,
+	0x3476: 0x0010, 0x3477: 0x0010, 0x3478: 0x0010, 0x3479: 0x0010, 0x347a: 0x0010, 0x347b: 0x0010,
+	0x347c: 0x0010, 0x347d: 0x0010, 0x347e: 0x0010, 0x347f: 0x0010,
+	// Block 0xd2, offset 0x3480
+	0x3480: 0x0010, 0x3481: 0x0010, 0x3482: 0x0010, 0x3483: 0x0010, 0x3484: 0x0010, 0x3485: 0x0010,
+	0x3486: 0x0010, 0x3487: 0x0010, 0x3488: 0x0010, 0x3489: 0x0010, 0x348a: 0x0010, 0x348b: 0x0010,
+	0x348c: 0x0010, 0x348d: 0x0010, 0x348e: 0x0010, 0x348f: 0x0010, 0x3490: 0x0010, 0x3491: 0x0010,
+	0x3492: 0x0010, 0x3493: 0x0010, 0x3494: 0x0010, 0x3495: 0x0010, 0x3496: 0x0010, 0x3497: 0x0010,
+	0x3498: 0x0010, 0x3499: 0x0010, 0x349a: 0x0010, 0x349b: 0x0010, 0x349c: 0x0010, 0x349d: 0x0010,
+	0x349e: 0x0010, 0x349f: 0x0010, 0x34a0: 0x0010, 0x34a1: 0x0010, 0x34a2: 0x0010, 0x34a3: 0x0010,
+	0x34a4: 0x0010, 0x34a5: 0x0010, 0x34a6: 0x0010, 0x34a7: 0x0010, 0x34a8: 0x0010, 0x34a9: 0x0010,
+	0x34aa: 0x0010, 0x34ab: 0x0010, 0x34ac: 0x0010, 0x34ad: 0x0010, 0x34ae: 0x0010, 0x34af: 0x0010,
+	0x34b0: 0x0010, 0x34b1: 0x0010, 0x34b2: 0x0010, 0x34b3: 0x0010, 0x34b4: 0x0010, 0x34b5: 0x0010,
+	0x34b6: 0x0010, 0x34b7: 0x0010, 0x34b8: 0x0010, 0x34b9: 0x0010, 0x34ba: 0x0010, 0x34bb: 0x0010,
+	// Block 0xd3, offset 0x34c0
+	0x34dd: 0x0020,
+	0x34de: 0x0020, 0x34e0: 0x0020, 0x34e1: 0x0020, 0x34e2: 0x0020, 0x34e3: 0x0020,
+	// Block 0xd4, offset 0x3500
+	0x3500: 0x0020, 0x3501: 0x0020, 0x3502: 0x0020, 0x3503: 0x0020, 0x3504: 0x0020, 0x3505: 0x0020,
+	0x3506: 0x0020, 0x3507: 0x0020, 0x3508: 0x0020, 0x3509: 0x0020, 0x350a: 0x0020, 0x350b: 0x0020,
+	0x350c: 0x0020, 0x350d: 0x0020, 0x350e: 0x0020, 0x350f: 0x0020, 0x3510: 0x0020, 0x3511: 0x0020,
+	0x3512: 0x0020, 0x3513: 0x0020, 0x3514: 0x0020, 0x3515: 0x0020, 0x3516: 0x0020, 0x3517: 0x0020,
+	0x3518: 0x0020, 0x3519: 0x0020, 0x351a: 0x0020, 0x351b: 0x0020, 0x351c: 0x0020, 0x351d: 0x0020,
+	0x351e: 0x0020, 0x351f: 0x0020, 0x3520: 0x0020, 0x3521: 0x0020, 0x3522: 0x0020, 0x3523: 0x0020,
+	0x3524: 0x0020, 0x3525: 0x0020, 0x3526: 0x0020, 0x3527: 0x0020, 0x3528: 0x0020, 0x3529: 0x0020,
+	0x352a: 0x0020, 0x352b: 0x0020, 0x352c: 0x0020, 0x352d: 0x0020,
+	0x3530: 0x0020, 0x3531: 0x0020, 0x3532: 0x0020, 0x3533: 0x0020, 0x3534: 0x0020, 0x3535: 0x0020,
+	0x3536: 0x0020, 0x3537: 0x0020, 0x3538: 0x0020, 0x3539: 0x0020, 0x353a: 0x0020, 0x353b: 0x0020,
+	0x353c: 0x0020, 0x353d: 0x0020, 0x353e: 0x0020, 0x353f: 0x0020,
+	// Block 0xd5, offset 0x3540
+	0x3540: 0x0020, 0x3541: 0x0020, 0x3542: 0x0020, 0x3543: 0x0020, 0x3544: 0x0020, 0x3545: 0x0020,
+	0x3546: 0x0020,
+	// Block 0xd6, offset 0x3580
+	0x35a7: 0x0020, 0x35a8: 0x0020, 0x35a9: 0x0020,
+	0x35b3: 0x0020, 0x35b4: 0x0020, 0x35b5: 0x0020,
+	0x35b6: 0x0020, 0x35b7: 0x0020, 0x35b8: 0x0020, 0x35b9: 0x0020, 0x35ba: 0x0020, 0x35bb: 0x0020,
+	0x35bc: 0x0020, 0x35bd: 0x0020, 0x35be: 0x0020, 0x35bf: 0x0020,
+	// Block 0xd7, offset 0x35c0
+	0x35c0: 0x0020, 0x35c1: 0x0020, 0x35c2: 0x0020, 0x35c5: 0x0020,
+	0x35c6: 0x0020, 0x35c7: 0x0020, 0x35c8: 0x0020, 0x35c9: 0x0020, 0x35ca: 0x0020, 0x35cb: 0x0020,
+	0x35ea: 0x0020, 0x35eb: 0x0020, 0x35ec: 0x0020, 0x35ed: 0x0020,
+	// Block 0xd8, offset 0x3600
+	0x3602: 0x0020, 0x3603: 0x0020, 0x3604: 0x0020,
+	// Block 0xd9, offset 0x3640
+	0x3640: 0x0010, 0x3641: 0x0010, 0x3642: 0x0010, 0x3643: 0x0010, 0x3644: 0x0010, 0x3645: 0x0010,
+	0x3646: 0x0010, 0x3647: 0x0010, 0x3648: 0x0010, 0x3649: 0x0010, 0x364a: 0x0010, 0x364b: 0x0010,
+	0x364c: 0x0010, 0x364d: 0x0010, 0x364e: 0x0010, 0x364f: 0x0010, 0x3650: 0x0010, 0x3651: 0x0010,
+	0x3652: 0x0010, 0x3653: 0x0010, 0x3654: 0x0010, 0x3655: 0x0010, 0x3656: 0x0010,
+	0x3660: 0x0010, 0x3661: 0x0010, 0x3662: 0x0010, 0x3663: 0x0010,
+	0x3664: 0x0010, 0x3665: 0x0010, 0x3666: 0x0010, 0x3667: 0x0010, 0x3668: 0x0010, 0x3669: 0x0010,
+	0x366a: 0x0010, 0x366b: 0x0010, 0x366c: 0x0010, 0x366d: 0x0010, 0x366e: 0x0010, 0x366f: 0x0010,
+	0x3670: 0x0010, 0x3671: 0x0010, 0x3672: 0x0010, 0x3673: 0x0010, 0x3674: 0x0010, 0x3675: 0x0010,
+	0x3676: 0x0010,
+	// Block 0xda, offset 0x3680
+	0x3680: 0x0020, 0x3681: 0x0020, 0x3682: 0x0020, 0x3683: 0x0020, 0x3684: 0x0020, 0x3685: 0x0020,
+	0x3686: 0x0020, 0x3687: 0x0020, 0x3688: 0x0020, 0x3689: 0x0020, 0x368a: 0x0020, 0x368b: 0x0020,
+	0x368c: 0x0020, 0x368d: 0x0020, 0x368e: 0x0020, 0x368f: 0x0020, 0x3690: 0x0020, 0x3691: 0x0020,
+	0x3692: 0x0020, 0x3693: 0x0020, 0x3694: 0x0020, 0x3695: 0x0020, 0x3696: 0x0020, 0x3697: 0x0020,
+	0x3698: 0x0020, 0x3699: 0x0020, 0x369a: 0x0020, 0x369b: 0x0020, 0x369c: 0x0020, 0x369d: 0x0020,
+	0x369e: 0x0020, 0x369f: 0x0020, 0x36a0: 0x0020, 0x36a1: 0x0020, 0x36a2: 0x0020, 0x36a3: 0x0020,
+	0x36a4: 0x0020, 0x36a5: 0x0020, 0x36a6: 0x0020, 0x36a7: 0x0020, 0x36a8: 0x0020, 0x36a9: 0x0020,
+	0x36aa: 0x0020, 0x36ab: 0x0020, 0x36ac: 0x0020, 0x36ad: 0x0020, 0x36ae: 0x0020, 0x36af: 0x0020,
+	0x36b0: 0x0020, 0x36b1: 0x0020, 0x36b2: 0x0020, 0x36b3: 0x0020, 0x36b4: 0x0020, 0x36b5: 0x0020,
+	0x36b6: 0x0020, 0x36bb: 0x0020,
+	0x36bc: 0x0020, 0x36bd: 0x0020, 0x36be: 0x0020, 0x36bf: 0x0020,
+	// Block 0xdb, offset 0x36c0
+	0x36c0: 0x0020, 0x36c1: 0x0020, 0x36c2: 0x0020, 0x36c3: 0x0020, 0x36c4: 0x0020, 0x36c5: 0x0020,
+	0x36c6: 0x0020, 0x36c7: 0x0020, 0x36c8: 0x0020, 0x36c9: 0x0020, 0x36ca: 0x0020, 0x36cb: 0x0020,
+	0x36cc: 0x0020, 0x36cd: 0x0020, 0x36ce: 0x0020, 0x36cf: 0x0020, 0x36d0: 0x0020, 0x36d1: 0x0020,
+	0x36d2: 0x0020, 0x36d3: 0x0020, 0x36d4: 0x0020, 0x36d5: 0x0020, 0x36d6: 0x0020, 0x36d7: 0x0020,
+	0x36d8: 0x0020, 0x36d9: 0x0020, 0x36da: 0x0020, 0x36db: 0x0020, 0x36dc: 0x0020, 0x36dd: 0x0020,
+	0x36de: 0x0020, 0x36df: 0x0020, 0x36e0: 0x0020, 0x36e1: 0x0020, 0x36e2: 0x0020, 0x36e3: 0x0020,
+	0x36e4: 0x0020, 0x36e5: 0x0020, 0x36e6: 0x0020, 0x36e7: 0x0020, 0x36e8: 0x0020, 0x36e9: 0x0020,
+	0x36ea: 0x0020, 0x36eb: 0x0020, 0x36ec: 0x0020,
+	0x36f5: 0x0020,
+	// Block 0xdc, offset 0x3700
+	0x3704: 0x0020,
+	0x371b: 0x0020, 0x371c: 0x0020, 0x371d: 0x0020,
+	0x371e: 0x0020, 0x371f: 0x0020, 0x3721: 0x0020, 0x3722: 0x0020, 0x3723: 0x0020,
+	0x3724: 0x0020, 0x3725: 0x0020, 0x3726: 0x0020, 0x3727: 0x0020, 0x3728: 0x0020, 0x3729: 0x0020,
+	0x372a: 0x0020, 0x372b: 0x0020, 0x372c: 0x0020, 0x372d: 0x0020, 0x372e: 0x0020, 0x372f: 0x0020,
+	// Block 0xdd, offset 0x3740
+	0x3740: 0x0020, 0x3741: 0x0020, 0x3742: 0x0020, 0x3743: 0x0020, 0x3744: 0x0020, 0x3745: 0x0020,
+	0x3746: 0x0020, 0x3748: 0x0020, 0x3749: 0x0020, 0x374a: 0x0020, 0x374b: 0x0020,
+	0x374c: 0x0020, 0x374d: 0x0020, 0x374e: 0x0020, 0x374f: 0x0020, 0x3750: 0x0020, 0x3751: 0x0020,
+	0x3752: 0x0020, 0x3753: 0x0020, 0x3754: 0x0020, 0x3755: 0x0020, 0x3756: 0x0020, 0x3757: 0x0020,
+	0x3758: 0x0020, 0x375b: 0x0020, 0x375c: 0x0020, 0x375d: 0x0020,
+	0x375e: 0x0020, 0x375f: 0x0020, 0x3760: 0x0020, 0x3761: 0x0020, 0x3763: 0x0020,
+	0x3764: 0x0020, 0x3766: 0x0020, 0x3767: 0x0020, 0x3768: 0x0020, 0x3769: 0x0020,
+	0x376a: 0x0020,
+	// Block 0xde, offset 0x3780
+	0x37ae: 0x0020,
+	// Block 0xdf, offset 0x37c0
+	0x37ec: 0x0020, 0x37ed: 0x0020, 0x37ee: 0x0020, 0x37ef: 0x0020,
+	// Block 0xe0, offset 0x3800
+	0x382e: 0x0020, 0x382f: 0x0020,
+	// Block 0xe1, offset 0x3840
+	0x3863: 0x0020,
+	0x3866: 0x0020,
+	0x386e: 0x0020, 0x386f: 0x0020,
+	0x3875: 0x0020,
+	// Block 0xe2, offset 0x3880
+	0x3890: 0x0020, 0x3891: 0x0020,
+	0x3892: 0x0020, 0x3893: 0x0020, 0x3894: 0x0020, 0x3895: 0x0020, 0x3896: 0x0020,
+	// Block 0xe3, offset 0x38c0
+	0x38c4: 0x0020, 0x38c5: 0x0020,
+	0x38c6: 0x0020, 0x38c7: 0x0020, 0x38c8: 0x0020, 0x38c9: 0x0020, 0x38ca: 0x0020,
+	// Block 0xe4, offset 0x3900
+	0x3904: 0x001c,
+	// Block 0xe5, offset 0x3940
+	0x394f: 0x001c,
+	// Block 0xe6, offset 0x3980
+	0x3980: 0x0001, 0x3981: 0x0001, 0x3982: 0x0001, 0x3983: 0x0001, 0x3984: 0x0001, 0x3985: 0x0001,
+	0x3986: 0x0001, 0x3987: 0x0001, 0x3988: 0x0001, 0x3989: 0x0001, 0x398a: 0x0001,
+	0x3990: 0x0001, 0x3991: 0x0001,
+	0x3992: 0x0001, 0x3993: 0x0001, 0x3994: 0x0001, 0x3995: 0x0001, 0x3996: 0x0001, 0x3997: 0x0001,
+	0x3998: 0x0001, 0x3999: 0x0001, 0x399a: 0x0001, 0x399b: 0x0001, 0x399c: 0x0001, 0x399d: 0x0001,
+	0x399e: 0x0001, 0x399f: 0x0001, 0x39a0: 0x0001, 0x39a1: 0x0001, 0x39a2: 0x0001, 0x39a3: 0x0001,
+	0x39a4: 0x0001, 0x39a5: 0x0001, 0x39a6: 0x0001, 0x39a7: 0x0001, 0x39a8: 0x0001, 0x39a9: 0x0001,
+	0x39aa: 0x0001, 0x39ab: 0x0001, 0x39ac: 0x0001, 0x39ad: 0x0001,
+	0x39b0: 0x0001, 0x39b1: 0x0001, 0x39b2: 0x0001, 0x39b3: 0x0001, 0x39b4: 0x0001, 0x39b5: 0x0001,
+	0x39b6: 0x0001, 0x39b7: 0x0001, 0x39b8: 0x0001, 0x39b9: 0x0001, 0x39ba: 0x0001, 0x39bb: 0x0001,
+	0x39bc: 0x0001, 0x39bd: 0x0001, 0x39be: 0x0001, 0x39bf: 0x0001,
+	// Block 0xe7, offset 0x39c0
+	0x39c0: 0x0001, 0x39c1: 0x0001, 0x39c2: 0x0001, 0x39c3: 0x0001, 0x39c4: 0x0001, 0x39c5: 0x0001,
+	0x39c6: 0x0001, 0x39c7: 0x0001, 0x39c8: 0x0001, 0x39c9: 0x0001, 0x39ca: 0x0001, 0x39cb: 0x0001,
+	0x39cc: 0x0001, 0x39cd: 0x0001, 0x39ce: 0x0001, 0x39cf: 0x0001, 0x39d0: 0x0001, 0x39d1: 0x0001,
+	0x39d2: 0x0001, 0x39d3: 0x0001, 0x39d4: 0x0001, 0x39d5: 0x0001, 0x39d6: 0x0001, 0x39d7: 0x0001,
+	0x39d8: 0x0001, 0x39d9: 0x0001, 0x39da: 0x0001, 0x39db: 0x0001, 0x39dc: 0x0001, 0x39dd: 0x0001,
+	0x39de: 0x0001, 0x39df: 0x0001, 0x39e0: 0x0001, 0x39e1: 0x0001, 0x39e2: 0x0001, 0x39e3: 0x0001,
+	0x39e4: 0x0001, 0x39e5: 0x0001, 0x39e6: 0x0001, 0x39e7: 0x0001, 0x39e8: 0x0001, 0x39e9: 0x0001,
+	0x39f0: 0x0005, 0x39f1: 0x0005, 0x39f2: 0x0001, 0x39f3: 0x0001, 0x39f4: 0x0001, 0x39f5: 0x0001,
+	0x39f6: 0x0001, 0x39f7: 0x0001, 0x39f8: 0x0001, 0x39f9: 0x0001, 0x39fa: 0x0001, 0x39fb: 0x0001,
+	0x39fc: 0x0001, 0x39fd: 0x0001, 0x39fe: 0x0005, 0x39ff: 0x0005,
+	// Block 0xe8, offset 0x3a00
+	0x3a00: 0x0001, 0x3a01: 0x0001, 0x3a02: 0x0001, 0x3a03: 0x0001, 0x3a04: 0x0001, 0x3a05: 0x0001,
+	0x3a06: 0x0001, 0x3a07: 0x0001, 0x3a08: 0x0001, 0x3a09: 0x0001, 0x3a0a: 0x0001, 0x3a0b: 0x0001,
+	0x3a0c: 0x0001, 0x3a0d: 0x0001, 0x3a0e: 0x001c, 0x3a0f: 0x0001, 0x3a10: 0x0001, 0x3a11: 0x001c,
+	0x3a12: 0x001c, 0x3a13: 0x001c, 0x3a14: 0x001c, 0x3a15: 0x001c, 0x3a16: 0x001c, 0x3a17: 0x001c,
+	0x3a18: 0x001c, 0x3a19: 0x001c, 0x3a1a: 0x001c, 0x3a1b: 0x0001, 0x3a1c: 0x0001, 0x3a1d: 0x0001,
+	0x3a1e: 0x0001, 0x3a1f: 0x0001, 0x3a20: 0x0001, 0x3a21: 0x0001, 0x3a22: 0x0001, 0x3a23: 0x0001,
+	0x3a24: 0x0001, 0x3a25: 0x0001, 0x3a26: 0x0001, 0x3a27: 0x0001, 0x3a28: 0x0001, 0x3a29: 0x0001,
+	0x3a2a: 0x0001, 0x3a2b: 0x0001, 0x3a2c: 0x0001,
+	// Block 0xe9, offset 0x3a40
+	0x3a66: 0x000c, 0x3a67: 0x000c, 0x3a68: 0x000c, 0x3a69: 0x000c,
+	0x3a6a: 0x000c, 0x3a6b: 0x000c, 0x3a6c: 0x000c, 0x3a6d: 0x000c, 0x3a6e: 0x000c, 0x3a6f: 0x000c,
+	0x3a70: 0x000c, 0x3a71: 0x000c, 0x3a72: 0x000c, 0x3a73: 0x000c, 0x3a74: 0x000c, 0x3a75: 0x000c,
+	0x3a76: 0x000c, 0x3a77: 0x000c, 0x3a78: 0x000c, 0x3a79: 0x000c, 0x3a7a: 0x000c, 0x3a7b: 0x000c,
+	0x3a7c: 0x000c, 0x3a7d: 0x000c, 0x3a7e: 0x000c, 0x3a7f: 0x000c,
+	// Block 0xea, offset 0x3a80
+	0x3a80: 0x0010, 0x3a81: 0x001c, 0x3a82: 0x0014,
+	0x3a90: 0x0010, 0x3a91: 0x0010,
+	0x3a92: 0x0010, 0x3a93: 0x0010, 0x3a94: 0x0010, 0x3a95: 0x0010, 0x3a96: 0x0010, 0x3a97: 0x0010,
+	0x3a98: 0x0010, 0x3a99: 0x0010, 0x3a9a: 0x001c, 0x3a9b: 0x0010, 0x3a9c: 0x0010, 0x3a9d: 0x0010,
+	0x3a9e: 0x0010, 0x3a9f: 0x0010, 0x3aa0: 0x0010, 0x3aa1: 0x0010, 0x3aa2: 0x0010, 0x3aa3: 0x0010,
+	0x3aa4: 0x0010, 0x3aa5: 0x0010, 0x3aa6: 0x0010, 0x3aa7: 0x0010, 0x3aa8: 0x0010, 0x3aa9: 0x0010,
+	0x3aaa: 0x0010, 0x3aab: 0x0010, 0x3aac: 0x0010, 0x3aad: 0x0010, 0x3aae: 0x0010, 0x3aaf: 0x001c,
+	0x3ab0: 0x0010, 0x3ab1: 0x0010, 0x3ab2: 0x001c, 0x3ab3: 0x001c, 0x3ab4: 0x001c, 0x3ab5: 0x001c,
+	0x3ab6: 0x001c, 0x3ab7: 0x0014, 0x3ab8: 0x001c, 0x3ab9: 0x001c, 0x3aba: 0x001c, 0x3abb: 0x0010,
+	// Block 0xeb, offset 0x3ac0
+	0x3ac0: 0x0010, 0x3ac1: 0x0010, 0x3ac2: 0x0010, 0x3ac3: 0x0010, 0x3ac4: 0x0010, 0x3ac5: 0x0010,
+	0x3ac6: 0x0010, 0x3ac7: 0x0010, 0x3ac8: 0x0010,
+	0x3ad0: 0x001c, 0x3ad1: 0x001c,
+	0x3ae0: 0x0010, 0x3ae1: 0x0010, 0x3ae2: 0x0010, 0x3ae3: 0x0010,
+	0x3ae4: 0x0010, 0x3ae5: 0x0010,
+	// Block 0xec, offset 0x3b00
+	0x3b00: 0x001c, 0x3b01: 0x001c, 0x3b02: 0x001c, 0x3b03: 0x001c, 0x3b04: 0x001c, 0x3b05: 0x001c,
+	0x3b06: 0x001c, 0x3b07: 0x001c, 0x3b08: 0x001c, 0x3b09: 0x001c, 0x3b0a: 0x001c, 0x3b0b: 0x001c,
+	0x3b0c: 0x001c, 0x3b0d: 0x001c, 0x3b0e: 0x001c, 0x3b0f: 0x001c, 0x3b10: 0x001c, 0x3b11: 0x001c,
+	0x3b12: 0x001c, 0x3b13: 0x001c, 0x3b14: 0x001c, 0x3b15: 0x001c, 0x3b16: 0x001c, 0x3b17: 0x001c,
+	0x3b18: 0x001c, 0x3b19: 0x001c, 0x3b1a: 0x001c, 0x3b1b: 0x001c, 0x3b1c: 0x001c, 0x3b1d: 0x001c,
+	0x3b1e: 0x001c, 0x3b1f: 0x001c, 0x3b20: 0x001c, 0x3b21: 0x0004,
+	0x3b24: 0x0004, 0x3b25: 0x0004, 0x3b26: 0x0004, 0x3b27: 0x0004, 0x3b28: 0x0004, 0x3b29: 0x0004,
+	0x3b2a: 0x0004, 0x3b2b: 0x0004, 0x3b2c: 0x0004, 0x3b2d: 0x001c, 0x3b2e: 0x001c, 0x3b2f: 0x001c,
+	0x3b30: 0x001c, 0x3b31: 0x001c, 0x3b32: 0x001c, 0x3b33: 0x001c, 0x3b34: 0x001c, 0x3b35: 0x001c,
+	0x3b36: 0x0004, 0x3b37: 0x001c, 0x3b38: 0x001c, 0x3b39: 0x001c, 0x3b3a: 0x001c, 0x3b3b: 0x001c,
+	0x3b3c: 0x001c, 0x3b3d: 0x001c, 0x3b3e: 0x001c, 0x3b3f: 0x001c,
+	// Block 0xed, offset 0x3b40
+	0x3b40: 0x001c, 0x3b41: 0x001c, 0x3b42: 0x001c, 0x3b43: 0x001c, 0x3b44: 0x001c, 0x3b45: 0x001c,
+	0x3b46: 0x001c, 0x3b47: 0x001c, 0x3b48: 0x001c, 0x3b49: 0x001c, 0x3b4a: 0x001c, 0x3b4b: 0x001c,
+	0x3b4c: 0x001c, 0x3b4d: 0x001c, 0x3b4e: 0x001c, 0x3b4f: 0x001c, 0x3b50: 0x001c, 0x3b51: 0x001c,
+	0x3b52: 0x001c, 0x3b53: 0x001c, 0x3b54: 0x001c, 0x3b55: 0x001c, 0x3b56: 0x001c, 0x3b57: 0x001c,
+	0x3b58: 0x001c, 0x3b59: 0x001c, 0x3b5a: 0x001c, 0x3b5b: 0x001c, 0x3b5c: 0x001c, 0x3b5d: 0x001c,
+	0x3b5e: 0x001c, 0x3b5f: 0x001c, 0x3b60: 0x001c, 0x3b61: 0x001c, 0x3b62: 0x001c, 0x3b63: 0x001c,
+	0x3b64: 0x001c, 0x3b65: 0x001c, 0x3b66: 0x001c, 0x3b67: 0x001c, 0x3b68: 0x001c, 0x3b69: 0x001c,
+	0x3b6a: 0x001c, 0x3b6b: 0x001c, 0x3b6c: 0x001c, 0x3b6d: 0x001c, 0x3b6e: 0x001c, 0x3b6f: 0x001c,
+	0x3b70: 0x001c, 0x3b71: 0x001c, 0x3b72: 0x001c, 0x3b73: 0x001c, 0x3b74: 0x001c, 0x3b75: 0x001c,
+	0x3b76: 0x001c, 0x3b77: 0x001c, 0x3b78: 0x001c, 0x3b79: 0x001c, 0x3b7a: 0x001c, 0x3b7b: 0x001c,
+	0x3b7c: 0x001c, 0x3b7d: 0x0004, 0x3b7e: 0x001c, 0x3b7f: 0x001c,
+	// Block 0xee, offset 0x3b80
+	0x3b80: 0x001c, 0x3b81: 0x001c, 0x3b82: 0x001c, 0x3b83: 0x001c, 0x3b84: 0x001c, 0x3b85: 0x001c,
+	0x3b86: 0x001c, 0x3b87: 0x001c, 0x3b88: 0x001c, 0x3b89: 0x001c, 0x3b8a: 0x001c, 0x3b8b: 0x001c,
+	0x3b8c: 0x001c, 0x3b8d: 0x001c, 0x3b8e: 0x001c, 0x3b8f: 0x001c, 0x3b90: 0x001c, 0x3b91: 0x001c,
+	0x3b92: 0x001c, 0x3b93: 0x001c, 0x3b96: 0x0004, 0x3b97: 0x0004,
+	0x3b99: 0x0004, 0x3b9a: 0x0004, 0x3b9b: 0x0004,
+	0x3b9e: 0x0004, 0x3b9f: 0x0004, 0x3ba0: 0x001c, 0x3ba1: 0x001c, 0x3ba2: 0x001c, 0x3ba3: 0x001c,
+	0x3ba4: 0x001c, 0x3ba5: 0x001c, 0x3ba6: 0x001c, 0x3ba7: 0x001c, 0x3ba8: 0x001c, 0x3ba9: 0x001c,
+	0x3baa: 0x001c, 0x3bab: 0x001c, 0x3bac: 0x001c, 0x3bad: 0x001c, 0x3bae: 0x001c, 0x3baf: 0x001c,
+	0x3bb0: 0x001c, 0x3bb1: 0x001c, 0x3bb2: 0x001c, 0x3bb3: 0x001c, 0x3bb4: 0x001c, 0x3bb5: 0x001c,
+	0x3bb6: 0x001c, 0x3bb7: 0x001c, 0x3bb8: 0x001c, 0x3bb9: 0x001c, 0x3bba: 0x001c, 0x3bbb: 0x001c,
+	0x3bbc: 0x001c, 0x3bbd: 0x001c, 0x3bbe: 0x001c, 0x3bbf: 0x001c,
+	// Block 0xef, offset 0x3bc0
+	0x3bc0: 0x001c, 0x3bc1: 0x001c, 0x3bc2: 0x001c, 0x3bc3: 0x001c, 0x3bc4: 0x001c, 0x3bc5: 0x001c,
+	0x3bc6: 0x001c, 0x3bc7: 0x001c, 0x3bc8: 0x001c, 0x3bc9: 0x001c, 0x3bca: 0x001c, 0x3bcb: 0x0004,
+	0x3bcc: 0x0004, 0x3bcd: 0x0004, 0x3bce: 0x0004, 0x3bcf: 0x001c, 0x3bd0: 0x001c, 0x3bd1: 0x001c,
+	0x3bd2: 0x001c, 0x3bd3: 0x001c, 0x3bd4: 0x0004, 0x3bd5: 0x0004, 0x3bd6: 0x0004, 0x3bd7: 0x0004,
+	0x3bd8: 0x0004, 0x3bd9: 0x0004, 0x3bda: 0x0004, 0x3bdb: 0x0004, 0x3bdc: 0x0004, 0x3bdd: 0x0004,
+	0x3bde: 0x0004, 0x3bdf: 0x0004, 0x3be0: 0x001c, 0x3be1: 0x001c, 0x3be2: 0x001c, 0x3be3: 0x001c,
+	0x3be4: 0x001c, 0x3be5: 0x001c, 0x3be6: 0x001c, 0x3be7: 0x001c, 0x3be8: 0x001c, 0x3be9: 0x001c,
+	0x3bea: 0x001c, 0x3beb: 0x001c, 0x3bec: 0x001c, 0x3bed: 0x001c, 0x3bee: 0x001c, 0x3bef: 0x001c,
+	0x3bf0: 0x001c, 0x3bf3: 0x0004, 0x3bf4: 0x001c, 0x3bf5: 0x0004,
+	0x3bf7: 0x0004, 0x3bf8: 0x001c, 0x3bf9: 0x001c, 0x3bfa: 0x001c, 0x3bfb: 0x001c,
+	0x3bfc: 0x001c, 0x3bfd: 0x001c, 0x3bfe: 0x001c, 0x3bff: 0x001c,
+	// Block 0xf0, offset 0x3c00
+	0x3c00: 0x001c, 0x3c01: 0x001c, 0x3c02: 0x001c, 0x3c03: 0x001c, 0x3c04: 0x001c, 0x3c05: 0x001c,
+	0x3c06: 0x001c, 0x3c07: 0x001c, 0x3c08: 0x001c, 0x3c09: 0x001c, 0x3c0a: 0x001c, 0x3c0b: 0x001c,
+	0x3c0c: 0x001c, 0x3c0d: 0x001c, 0x3c0e: 0x001c, 0x3c0f: 0x001c, 0x3c10: 0x001c, 0x3c11: 0x001c,
+	0x3c12: 0x001c, 0x3c13: 0x001c, 0x3c14: 0x001c, 0x3c15: 0x001c, 0x3c16: 0x001c, 0x3c17: 0x001c,
+	0x3c18: 0x001c, 0x3c19: 0x001c, 0x3c1a: 0x001c, 0x3c1b: 0x001c, 0x3c1c: 0x001c, 0x3c1d: 0x001c,
+	0x3c1e: 0x001c, 0x3c1f: 0x001c, 0x3c20: 0x001c, 0x3c21: 0x001c, 0x3c22: 0x001c, 0x3c23: 0x001c,
+	0x3c24: 0x001c, 0x3c25: 0x001c, 0x3c26: 0x001c, 0x3c27: 0x001c, 0x3c28: 0x001c, 0x3c29: 0x001c,
+	0x3c2a: 0x001c, 0x3c2b: 0x001c, 0x3c2c: 0x001c, 0x3c2d: 0x001c, 0x3c2e: 0x001c, 0x3c2f: 0x001c,
+	0x3c30: 0x001c, 0x3c31: 0x001c, 0x3c32: 0x001c, 0x3c33: 0x001c, 0x3c34: 0x001c, 0x3c35: 0x001c,
+	0x3c36: 0x001c, 0x3c37: 0x001c, 0x3c38: 0x001c, 0x3c39: 0x001c, 0x3c3a: 0x001c, 0x3c3b: 0x001c,
+	0x3c3c: 0x001c, 0x3c3d: 0x001c, 0x3c3e: 0x001c, 0x3c3f: 0x0004,
+	// Block 0xf1, offset 0x3c40
+	0x3c40: 0x001c, 0x3c41: 0x0004, 0x3c42: 0x001c, 0x3c43: 0x001c, 0x3c44: 0x001c, 0x3c45: 0x001c,
+	0x3c46: 0x001c, 0x3c47: 0x001c, 0x3c48: 0x001c, 0x3c49: 0x001c, 0x3c4a: 0x001c, 0x3c4b: 0x001c,
+	0x3c4c: 0x001c, 0x3c4d: 0x001c, 0x3c4e: 0x001c, 0x3c4f: 0x001c, 0x3c50: 0x001c, 0x3c51: 0x001c,
+	0x3c52: 0x001c, 0x3c53: 0x001c, 0x3c54: 0x001c, 0x3c55: 0x001c, 0x3c56: 0x001c, 0x3c57: 0x001c,
+	0x3c58: 0x001c, 0x3c59: 0x001c, 0x3c5a: 0x001c, 0x3c5b: 0x001c, 0x3c5c: 0x001c, 0x3c5d: 0x001c,
+	0x3c5e: 0x001c, 0x3c5f: 0x001c, 0x3c60: 0x001c, 0x3c61: 0x001c, 0x3c62: 0x001c, 0x3c63: 0x001c,
+	0x3c64: 0x001c, 0x3c65: 0x001c, 0x3c66: 0x001c, 0x3c67: 0x001c, 0x3c68: 0x001c, 0x3c69: 0x001c,
+	0x3c6a: 0x001c, 0x3c6b: 0x001c, 0x3c6c: 0x001c, 0x3c6d: 0x001c, 0x3c6e: 0x001c, 0x3c6f: 0x001c,
+	0x3c70: 0x001c, 0x3c71: 0x001c, 0x3c72: 0x001c, 0x3c73: 0x001c, 0x3c74: 0x001c, 0x3c75: 0x001c,
+	0x3c76: 0x001c, 0x3c77: 0x001c, 0x3c78: 0x001c, 0x3c79: 0x001c, 0x3c7a: 0x001c, 0x3c7b: 0x001c,
+	0x3c7c: 0x001c, 0x3c7d: 0x001c, 0x3c7e: 0x001c, 0x3c7f: 0x001c,
+	// Block 0xf2, offset 0x3c80
+	0x3c80: 0x001c, 0x3c81: 0x001c, 0x3c82: 0x001c, 0x3c83: 0x001c, 0x3c84: 0x001c, 0x3c85: 0x001c,
+	0x3c86: 0x001c, 0x3c87: 0x001c, 0x3c88: 0x001c, 0x3c89: 0x001c, 0x3c8a: 0x001c, 0x3c8b: 0x001c,
+	0x3c8c: 0x001c, 0x3c8d: 0x001c, 0x3c8e: 0x001c, 0x3c8f: 0x001c, 0x3c90: 0x001c, 0x3c91: 0x001c,
+	0x3c92: 0x001c, 0x3c93: 0x001c, 0x3c94: 0x001c, 0x3c95: 0x001c, 0x3c96: 0x001c, 0x3c97: 0x001c,
+	0x3c98: 0x001c, 0x3c99: 0x001c, 0x3c9a: 0x001c, 0x3c9b: 0x001c, 0x3c9c: 0x001c, 0x3c9d: 0x001c,
+	0x3c9e: 0x001c, 0x3c9f: 0x001c, 0x3ca0: 0x001c, 0x3ca1: 0x001c, 0x3ca2: 0x001c, 0x3ca3: 0x001c,
+	0x3ca4: 0x001c, 0x3ca5: 0x001c, 0x3ca6: 0x001c, 0x3ca7: 0x001c, 0x3ca8: 0x001c, 0x3ca9: 0x001c,
+	0x3caa: 0x001c, 0x3cab: 0x001c, 0x3cac: 0x001c, 0x3cad: 0x001c, 0x3cae: 0x001c, 0x3caf: 0x001c,
+	0x3cb0: 0x001c, 0x3cb1: 0x001c, 0x3cb2: 0x001c, 0x3cb3: 0x001c, 0x3cb4: 0x001c, 0x3cb5: 0x001c,
+	0x3cb6: 0x001c, 0x3cb7: 0x001c, 0x3cb8: 0x001c, 0x3cb9: 0x001c, 0x3cba: 0x001c, 0x3cbb: 0x001c,
+	0x3cbc: 0x001c, 0x3cbd: 0x001c, 0x3cbe: 0x001c, 0x3cbf: 0x001c,
+	// Block 0xf3, offset 0x3cc0
+	0x3cc0: 0x001c, 0x3cc1: 0x001c, 0x3cc2: 0x001c, 0x3cc3: 0x001c, 0x3cc4: 0x001c, 0x3cc5: 0x001c,
+	0x3cc6: 0x001c, 0x3cc7: 0x001c, 0x3cc8: 0x001c, 0x3cc9: 0x001c, 0x3cca: 0x001c, 0x3ccb: 0x001c,
+	0x3ccc: 0x001c, 0x3ccd: 0x001c, 0x3cce: 0x001c, 0x3ccf: 0x001c, 0x3cd0: 0x001c, 0x3cd1: 0x001c,
+	0x3cd2: 0x001c, 0x3cd3: 0x001c, 0x3cd4: 0x001c, 0x3cd5: 0x001c, 0x3cd6: 0x001c, 0x3cd7: 0x001c,
+	0x3cd8: 0x001c, 0x3cd9: 0x001c, 0x3cda: 0x001c, 0x3cdb: 0x001c, 0x3cdc: 0x001c, 0x3cdd: 0x001c,
+	0x3cde: 0x001c, 0x3cdf: 0x001c, 0x3ce0: 0x001c, 0x3ce1: 0x001c, 0x3ce2: 0x001c, 0x3ce3: 0x001c,
+	0x3ce4: 0x001c, 0x3ce5: 0x001c, 0x3ce6: 0x001c, 0x3ce7: 0x001c, 0x3ce8: 0x001c, 0x3ce9: 0x001c,
+	0x3cea: 0x001c, 0x3ceb: 0x001c, 0x3cec: 0x001c, 0x3ced: 0x001c, 0x3cee: 0x001c, 0x3cef: 0x001c,
+	0x3cf0: 0x001c, 0x3cf1: 0x001c, 0x3cf2: 0x001c, 0x3cf3: 0x001c, 0x3cf4: 0x001c, 0x3cf5: 0x001c,
+	0x3cf6: 0x001c, 0x3cf7: 0x001c, 0x3cf8: 0x001c, 0x3cf9: 0x001c, 0x3cfa: 0x001c, 0x3cfb: 0x001c,
+	0x3cfc: 0x001c, 0x3cfd: 0x0004, 0x3cff: 0x001c,
+	// Block 0xf4, offset 0x3d00
+	0x3d00: 0x001c, 0x3d01: 0x001c, 0x3d02: 0x001c, 0x3d03: 0x001c, 0x3d04: 0x001c, 0x3d05: 0x001c,
+	0x3d06: 0x001c, 0x3d07: 0x001c, 0x3d08: 0x001c, 0x3d09: 0x001c, 0x3d0a: 0x001c, 0x3d0b: 0x001c,
+	0x3d0c: 0x001c, 0x3d0d: 0x001c, 0x3d0e: 0x001c, 0x3d0f: 0x001c, 0x3d10: 0x001c, 0x3d11: 0x001c,
+	0x3d12: 0x001c, 0x3d13: 0x001c, 0x3d14: 0x001c, 0x3d15: 0x001c, 0x3d16: 0x001c, 0x3d17: 0x001c,
+	0x3d18: 0x001c, 0x3d19: 0x001c, 0x3d1a: 0x001c, 0x3d1b: 0x001c, 0x3d1c: 0x001c, 0x3d1d: 0x001c,
+	0x3d1e: 0x001c, 0x3d1f: 0x001c, 0x3d20: 0x001c, 0x3d21: 0x001c, 0x3d22: 0x001c, 0x3d23: 0x001c,
+	0x3d24: 0x001c, 0x3d25: 0x001c, 0x3d26: 0x001c, 0x3d27: 0x001c, 0x3d28: 0x001c, 0x3d29: 0x001c,
+	0x3d2a: 0x001c, 0x3d2b: 0x001c, 0x3d2c: 0x001c, 0x3d2d: 0x001c, 0x3d2e: 0x001c, 0x3d2f: 0x001c,
+	0x3d30: 0x001c, 0x3d31: 0x001c, 0x3d32: 0x001c, 0x3d33: 0x001c, 0x3d34: 0x001c, 0x3d35: 0x001c,
+	0x3d36: 0x001c, 0x3d37: 0x001c, 0x3d38: 0x001c, 0x3d39: 0x001c, 0x3d3a: 0x001c, 0x3d3b: 0x001c,
+	0x3d3c: 0x001c, 0x3d3d: 0x001c,
+	// Block 0xf5, offset 0x3d40
+	0x3d49: 0x0004, 0x3d4a: 0x0004, 0x3d4b: 0x001c,
+	0x3d4c: 0x001c, 0x3d4d: 0x001c, 0x3d4e: 0x001c, 0x3d50: 0x001c, 0x3d51: 0x001c,
+	0x3d52: 0x001c, 0x3d53: 0x001c, 0x3d54: 0x001c, 0x3d55: 0x001c, 0x3d56: 0x001c, 0x3d57: 0x001c,
+	0x3d58: 0x001c, 0x3d59: 0x001c, 0x3d5a: 0x001c, 0x3d5b: 0x001c, 0x3d5c: 0x001c, 0x3d5d: 0x001c,
+	0x3d5e: 0x001c, 0x3d5f: 0x001c, 0x3d60: 0x001c, 0x3d61: 0x001c, 0x3d62: 0x001c, 0x3d63: 0x001c,
+	0x3d64: 0x001c, 0x3d65: 0x001c, 0x3d66: 0x001c, 0x3d67: 0x001c,
+	0x3d6f: 0x0004,
+	0x3d70: 0x0004, 0x3d73: 0x0004, 0x3d74: 0x0004, 0x3d75: 0x0004,
+	0x3d76: 0x0004, 0x3d77: 0x0004, 0x3d78: 0x0004, 0x3d79: 0x0004, 0x3d7a: 0x001c,
+	// Block 0xf6, offset 0x3d80
+	0x3d87: 0x0004, 0x3d8a: 0x0004, 0x3d8b: 0x0004,
+	0x3d8c: 0x0004, 0x3d8d: 0x0004, 0x3d90: 0x0004,
+	0x3d95: 0x001c, 0x3d96: 0x001c,
+	0x3da4: 0x001c, 0x3da5: 0x0004, 0x3da8: 0x0004,
+	0x3db1: 0x0004, 0x3db2: 0x0004,
+	0x3dbc: 0x0004,
+	// Block 0xf7, offset 0x3dc0
+	0x3dc2: 0x0004, 0x3dc3: 0x0004, 0x3dc4: 0x0004,
+	0x3dd1: 0x0004,
+	0x3dd2: 0x0004, 0x3dd3: 0x0004,
+	0x3ddc: 0x0004, 0x3ddd: 0x0004,
+	0x3dde: 0x0004, 0x3de1: 0x0004, 0x3de3: 0x0004,
+	0x3de8: 0x0004,
+	0x3def: 0x0004,
+	0x3df3: 0x0004,
+	0x3dfa: 0x0004, 0x3dfb: 0x001c,
+	0x3dfc: 0x001c, 0x3dfd: 0x001c, 0x3dfe: 0x001c, 0x3dff: 0x001c,
+	// Block 0xf8, offset 0x3e00
+	0x3e00: 0x001c, 0x3e01: 0x001c, 0x3e02: 0x001c, 0x3e03: 0x001c, 0x3e04: 0x001c, 0x3e05: 0x001c,
+	0x3e06: 0x001c, 0x3e07: 0x001c, 0x3e08: 0x001c, 0x3e09: 0x001c, 0x3e0a: 0x001c, 0x3e0b: 0x001c,
+	0x3e0c: 0x001c, 0x3e0d: 0x001c, 0x3e0e: 0x001c, 0x3e0f: 0x001c,
+	// Block 0xf9, offset 0x3e40
+	0x3e40: 0x001c, 0x3e41: 0x001c, 0x3e42: 0x001c, 0x3e43: 0x001c, 0x3e44: 0x001c, 0x3e45: 0x001c,
+	0x3e4b: 0x0004,
+	0x3e4c: 0x001c, 0x3e4d: 0x0004, 0x3e4e: 0x0004, 0x3e4f: 0x0004, 0x3e50: 0x001c, 0x3e51: 0x001c,
+	0x3e52: 0x001c, 0x3e55: 0x001c, 0x3e56: 0x001c, 0x3e57: 0x001c,
+	0x3e58: 0x001c, 0x3e5c: 0x001c, 0x3e5d: 0x001c,
+	0x3e5e: 0x001c, 0x3e5f: 0x001c, 0x3e60: 0x0004, 0x3e61: 0x0004, 0x3e62: 0x0004, 0x3e63: 0x0004,
+	0x3e64: 0x0004, 0x3e65: 0x0004, 0x3e69: 0x0004,
+	0x3e6b: 0x001c, 0x3e6c: 0x001c,
+	0x3e70: 0x0004, 0x3e73: 0x0004, 0x3e74: 0x001c, 0x3e75: 0x001c,
+	0x3e76: 0x001c, 0x3e77: 0x001c, 0x3e78: 0x001c, 0x3e79: 0x001c, 0x3e7a: 0x001c, 0x3e7b: 0x001c,
+	0x3e7c: 0x001c,
+	// Block 0xfa, offset 0x3e80
+	0x3ea0: 0x001c, 0x3ea1: 0x001c, 0x3ea2: 0x001c, 0x3ea3: 0x001c,
+	0x3ea4: 0x001c, 0x3ea5: 0x001c, 0x3ea6: 0x001c, 0x3ea7: 0x001c, 0x3ea8: 0x001c, 0x3ea9: 0x001c,
+	0x3eaa: 0x001c, 0x3eab: 0x001c,
+	0x3eb0: 0x001c,
+	// Block 0xfb, offset 0x3ec0
+	0x3ecc: 0x001c, 0x3ecd: 0x001c, 0x3ece: 0x001c, 0x3ecf: 0x001c, 0x3ed0: 0x001c, 0x3ed1: 0x001c,
+	0x3ed2: 0x001c, 0x3ed3: 0x001c, 0x3ed4: 0x001c, 0x3ed5: 0x001c, 0x3ed6: 0x001c, 0x3ed7: 0x001c,
+	0x3ed8: 0x001c, 0x3ed9: 0x001c, 0x3eda: 0x001c, 0x3edb: 0x001c, 0x3edc: 0x001c, 0x3edd: 0x001c,
+	0x3ede: 0x001c, 0x3edf: 0x001c, 0x3ee0: 0x001c, 0x3ee1: 0x001c, 0x3ee2: 0x001c, 0x3ee3: 0x001c,
+	0x3ee4: 0x001c, 0x3ee5: 0x001c, 0x3ee6: 0x001c, 0x3ee7: 0x001c, 0x3ee8: 0x001c, 0x3ee9: 0x001c,
+	0x3eea: 0x001c, 0x3eeb: 0x001c, 0x3eec: 0x001c, 0x3eed: 0x001c, 0x3eee: 0x001c, 0x3eef: 0x001c,
+	0x3ef0: 0x001c, 0x3ef1: 0x001c, 0x3ef2: 0x001c, 0x3ef3: 0x001c, 0x3ef4: 0x001c, 0x3ef5: 0x001c,
+	0x3ef6: 0x001c, 0x3ef7: 0x001c, 0x3ef8: 0x001c, 0x3ef9: 0x001c, 0x3efa: 0x001c,
+	0x3efc: 0x001c, 0x3efd: 0x001c, 0x3efe: 0x001c, 0x3eff: 0x001c,
+	// Block 0xfc, offset 0x3f00
+	0x3f00: 0x001c, 0x3f01: 0x001c, 0x3f02: 0x001c, 0x3f03: 0x001c, 0x3f04: 0x001c, 0x3f05: 0x001c,
+	0x3f07: 0x001c, 0x3f08: 0x001c, 0x3f09: 0x001c, 0x3f0a: 0x001c, 0x3f0b: 0x001c,
+	0x3f0c: 0x001c, 0x3f0d: 0x001c, 0x3f0e: 0x001c, 0x3f0f: 0x001c, 0x3f10: 0x001c, 0x3f11: 0x001c,
+	0x3f12: 0x001c, 0x3f13: 0x001c, 0x3f14: 0x001c, 0x3f15: 0x001c, 0x3f16: 0x001c, 0x3f17: 0x001c,
+	0x3f18: 0x001c, 0x3f19: 0x001c, 0x3f1a: 0x001c, 0x3f1b: 0x001c, 0x3f1c: 0x001c, 0x3f1d: 0x001c,
+	0x3f1e: 0x001c, 0x3f1f: 0x001c, 0x3f20: 0x001c, 0x3f21: 0x001c, 0x3f22: 0x001c, 0x3f23: 0x001c,
+	0x3f24: 0x001c, 0x3f25: 0x001c, 0x3f26: 0x001c, 0x3f27: 0x001c, 0x3f28: 0x001c, 0x3f29: 0x001c,
+	0x3f2a: 0x001c, 0x3f2b: 0x001c, 0x3f2c: 0x001c, 0x3f2d: 0x001c, 0x3f2e: 0x001c, 0x3f2f: 0x001c,
+	0x3f30: 0x001c, 0x3f31: 0x001c, 0x3f32: 0x001c, 0x3f33: 0x001c, 0x3f34: 0x001c, 0x3f35: 0x001c,
+	0x3f36: 0x001c, 0x3f37: 0x001c, 0x3f38: 0x001c, 0x3f39: 0x001c, 0x3f3a: 0x001c, 0x3f3b: 0x001c,
+	0x3f3c: 0x001c, 0x3f3d: 0x001c, 0x3f3e: 0x001c, 0x3f3f: 0x001c,
+	// Block 0xfd, offset 0x3f40
+	0x3f70: 0x001c, 0x3f71: 0x001c, 0x3f72: 0x001c, 0x3f73: 0x001c, 0x3f74: 0x001c, 0x3f75: 0x001c,
+	0x3f76: 0x001c, 0x3f77: 0x001c, 0x3f78: 0x001c, 0x3f79: 0x001c, 0x3f7a: 0x001c, 0x3f7b: 0x001c,
+	0x3f7c: 0x001c,
+	// Block 0xfe, offset 0x3f80
+	0x3f80: 0x001c, 0x3f81: 0x001c, 0x3f82: 0x001c, 0x3f83: 0x001c, 0x3f84: 0x001c, 0x3f85: 0x001c,
+	0x3f86: 0x001c, 0x3f87: 0x001c, 0x3f88: 0x001c, 0x3f89: 0x001c, 0x3f8a: 0x001c,
+	0x3f8e: 0x001c, 0x3f8f: 0x001c, 0x3f90: 0x001c, 0x3f91: 0x001c,
+	0x3f92: 0x001c, 0x3f93: 0x001c, 0x3f94: 0x001c, 0x3f95: 0x001c, 0x3f96: 0x001c, 0x3f97: 0x001c,
+	0x3f98: 0x001c, 0x3f99: 0x001c, 0x3f9a: 0x001c, 0x3f9b: 0x001c, 0x3f9c: 0x001c, 0x3f9d: 0x001c,
+	0x3f9e: 0x001c, 0x3f9f: 0x001c, 0x3fa0: 0x001c, 0x3fa1: 0x001c, 0x3fa2: 0x001c, 0x3fa3: 0x001c,
+	0x3fa4: 0x001c, 0x3fa5: 0x001c, 0x3fa6: 0x001c, 0x3fa7: 0x001c, 0x3fa8: 0x001c, 0x3fa9: 0x001c,
+	0x3faa: 0x001c, 0x3fab: 0x001c, 0x3fac: 0x001c, 0x3fad: 0x001c, 0x3fae: 0x001c, 0x3faf: 0x001c,
+	0x3fb0: 0x001c, 0x3fb1: 0x001c, 0x3fb2: 0x001c, 0x3fb3: 0x001c, 0x3fb4: 0x001c, 0x3fb5: 0x001c,
+	0x3fb6: 0x001c, 0x3fb7: 0x001c, 0x3fb8: 0x001c, 0x3fb9: 0x001c, 0x3fba: 0x001c, 0x3fbb: 0x001c,
+	0x3fbc: 0x001c, 0x3fbd: 0x001c, 0x3fbe: 0x001c, 0x3fbf: 0x001c,
+	// Block 0xff, offset 0x3fc0
+	0x3fc0: 0x001c, 0x3fc1: 0x001c, 0x3fc2: 0x001c, 0x3fc3: 0x001c, 0x3fc4: 0x001c, 0x3fc5: 0x001c,
+	0x3fc6: 0x001c, 0x3fc8: 0x001c,
+	0x3fcd: 0x001c, 0x3fce: 0x001c, 0x3fcf: 0x001c, 0x3fd0: 0x001c, 0x3fd1: 0x001c,
+	0x3fd2: 0x001c, 0x3fd3: 0x001c, 0x3fd4: 0x001c, 0x3fd5: 0x001c, 0x3fd6: 0x001c, 0x3fd7: 0x001c,
+	0x3fd8: 0x001c, 0x3fd9: 0x001c, 0x3fda: 0x001c, 0x3fdb: 0x001c, 0x3fdc: 0x001c,
+	0x3fdf: 0x001c, 0x3fe0: 0x001c, 0x3fe1: 0x001c, 0x3fe2: 0x001c, 0x3fe3: 0x001c,
+	0x3fe4: 0x001c, 0x3fe5: 0x001c, 0x3fe6: 0x001c, 0x3fe7: 0x001c, 0x3fe8: 0x001c, 0x3fe9: 0x001c,
+	0x3fea: 0x001c, 0x3fef: 0x001c,
+	0x3ff0: 0x001c, 0x3ff1: 0x001c, 0x3ff2: 0x001c, 0x3ff3: 0x001c, 0x3ff4: 0x001c, 0x3ff5: 0x001c,
+	0x3ff6: 0x001c, 0x3ff7: 0x001c, 0x3ff8: 0x001c,
+	// Block 0x100, offset 0x4000
+	0x4000: 0x0010, 0x4001: 0x0010, 0x4002: 0x0010, 0x4003: 0x0010, 0x4004: 0x0010, 0x4005: 0x0010,
+	0x4006: 0x0010, 0x4007: 0x0010, 0x4008: 0x0010, 0x4009: 0x0010, 0x400a: 0x0010, 0x400b: 0x0010,
+	0x400c: 0x0010, 0x400d: 0x0010, 0x400e: 0x0010, 0x400f: 0x0010, 0x4010: 0x0010, 0x4011: 0x0010,
+	0x4012: 0x0010, 0x4013: 0x0010, 0x4014: 0x0010, 0x4015: 0x0010, 0x4016: 0x0010, 0x4017: 0x0010,
+	0x4018: 0x0010, 0x4019: 0x0010, 0x401a: 0x0010, 0x401b: 0x0010, 0x401c: 0x0010, 0x401d: 0x0010,
+	0x401e: 0x0010, 0x401f: 0x0010, 0x4020: 0x0010, 0x4021: 0x0010, 0x4022: 0x0010, 0x4023: 0x0010,
+	0x4024: 0x0010, 0x4025: 0x0010, 0x4026: 0x0010, 0x4027: 0x0010, 0x4028: 0x0010, 0x4029: 0x0010,
+	0x402a: 0x0010, 0x402b: 0x0010, 0x402c: 0x0010, 0x402d: 0x0010, 0x402e: 0x0010, 0x402f: 0x0010,
+	0x4030: 0x0010, 0x4031: 0x0010, 0x4032: 0x0010, 0x4033: 0x0010, 0x4034: 0x0010, 0x4035: 0x0010,
+	0x4036: 0x0010, 0x4037: 0x0010, 0x4038: 0x0010, 0x4039: 0x0010, 0x403a: 0x0010, 0x403b: 0x0010,
+	0x403c: 0x0010, 0x403d: 0x0010,
+	// Block 0x101, offset 0x4040
+	0x4041: 0x0020,
+	0x4060: 0x0020, 0x4061: 0x0020, 0x4062: 0x0020, 0x4063: 0x0020,
+	0x4064: 0x0020, 0x4065: 0x0020, 0x4066: 0x0020, 0x4067: 0x0020, 0x4068: 0x0020, 0x4069: 0x0020,
+	0x406a: 0x0020, 0x406b: 0x0020, 0x406c: 0x0020, 0x406d: 0x0020, 0x406e: 0x0020, 0x406f: 0x0020,
+	0x4070: 0x0020, 0x4071: 0x0020, 0x4072: 0x0020, 0x4073: 0x0020, 0x4074: 0x0020, 0x4075: 0x0020,
+	0x4076: 0x0020, 0x4077: 0x0020, 0x4078: 0x0020, 0x4079: 0x0020, 0x407a: 0x0020, 0x407b: 0x0020,
+	0x407c: 0x0020, 0x407d: 0x0020, 0x407e: 0x0020, 0x407f: 0x0020,
+	// Block 0x102, offset 0x4080
+	0x4080: 0x0001, 0x4081: 0x0001, 0x4082: 0x0001, 0x4083: 0x0001, 0x4084: 0x0001, 0x4085: 0x0001,
+	0x4086: 0x0001, 0x4087: 0x0001, 0x4088: 0x0001, 0x4089: 0x0001, 0x408a: 0x0001, 0x408b: 0x0001,
+	0x408c: 0x0001, 0x408d: 0x0001, 0x408e: 0x0001, 0x408f: 0x0001, 0x4090: 0x0001, 0x4091: 0x0001,
+	0x4092: 0x0001, 0x4093: 0x0001, 0x4094: 0x0001, 0x4095: 0x0001, 0x4096: 0x0001, 0x4097: 0x0001,
+	0x4098: 0x0001, 0x4099: 0x0001, 0x409a: 0x0001, 0x409b: 0x0001, 0x409c: 0x0001, 0x409d: 0x0001,
+	0x409e: 0x0001, 0x409f: 0x0001, 0x40a0: 0x0001, 0x40a1: 0x0001, 0x40a2: 0x0001, 0x40a3: 0x0001,
+	0x40a4: 0x0001, 0x40a5: 0x0001, 0x40a6: 0x0001, 0x40a7: 0x0001, 0x40a8: 0x0001, 0x40a9: 0x0001,
+	0x40aa: 0x0001, 0x40ab: 0x0001, 0x40ac: 0x0001, 0x40ad: 0x0001, 0x40ae: 0x0001, 0x40af: 0x0001,
+	0x40b0: 0x0001, 0x40b1: 0x0001, 0x40b2: 0x0001, 0x40b3: 0x0001, 0x40b4: 0x0001, 0x40b5: 0x0001,
+	0x40b6: 0x0001, 0x40b7: 0x0001, 0x40b8: 0x0001, 0x40b9: 0x0001, 0x40ba: 0x0001, 0x40bb: 0x0001,
+	0x40bc: 0x0001, 0x40bd: 0x0001,
+}
+
+// widthIndex: 30 blocks, 1920 entries, 3840 bytes
+// Block 0 is the zero block.
+var widthIndex = [1920]widthProperty{
+	// Block 0x0, offset 0x0
+	// Block 0x1, offset 0x40
+	// Block 0x2, offset 0x80
+	// Block 0x3, offset 0xc0
+	0xc2: 0x01, 0xc3: 0x02, 0xc4: 0x03, 0xc5: 0x04, 0xc7: 0x05,
+	0xc9: 0x06, 0xcb: 0x07, 0xcc: 0x08, 0xcd: 0x09, 0xce: 0x0a, 0xcf: 0x0b,
+	0xd0: 0x0c, 0xd1: 0x0d, 0xd2: 0x0e, 0xd6: 0x0f, 0xd7: 0x10,
+	0xd8: 0x11, 0xd9: 0x12, 0xdb: 0x13, 0xdc: 0x14, 0xdd: 0x15, 0xde: 0x16, 0xdf: 0x17,
+	0xe0: 0x02, 0xe1: 0x03, 0xe2: 0x04, 0xe3: 0x05, 0xe4: 0x06, 0xe5: 0x06, 0xe6: 0x06, 0xe7: 0x06,
+	0xe8: 0x06, 0xe9: 0x06, 0xea: 0x07, 0xeb: 0x06, 0xec: 0x06, 0xed: 0x08, 0xee: 0x09, 0xef: 0x0a,
+	0xf0: 0x17, 0xf3: 0x1a, 0xf4: 0x1b,
+	// Block 0x4, offset 0x100
+	0x120: 0x18, 0x121: 0x19, 0x122: 0x1a, 0x123: 0x1b, 0x124: 0x1c, 0x125: 0x1d, 0x126: 0x1e, 0x127: 0x1f,
+	0x128: 0x20, 0x129: 0x21, 0x12a: 0x20, 0x12b: 0x22, 0x12c: 0x23, 0x12d: 0x24, 0x12e: 0x25, 0x12f: 0x26,
+	0x130: 0x27, 0x131: 0x28, 0x132: 0x23, 0x133: 0x29, 0x134: 0x2a, 0x135: 0x2b, 0x136: 0x2c, 0x137: 0x2d,
+	0x138: 0x2e, 0x139: 0x2f, 0x13a: 0x30, 0x13b: 0x31, 0x13c: 0x32, 0x13d: 0x33, 0x13e: 0x34, 0x13f: 0x35,
+	// Block 0x5, offset 0x140
+	0x140: 0x36, 0x141: 0x37, 0x142: 0x38, 0x144: 0x39, 0x145: 0x3a, 0x146: 0x3b, 0x147: 0x3b,
+	0x14d: 0x3c,
+	0x15c: 0x3d, 0x15d: 0x3e, 0x15e: 0x3f, 0x15f: 0x40,
+	0x160: 0x41, 0x162: 0x42, 0x164: 0x43,
+	0x168: 0x44, 0x169: 0x45, 0x16a: 0x46, 0x16b: 0x47, 0x16c: 0x48, 0x16d: 0x49, 0x16e: 0x4a, 0x16f: 0x4b,
+	0x170: 0x4c, 0x173: 0x4d, 0x177: 0x3b,
+	// Block 0x6, offset 0x180
+	0x180: 0x4e, 0x181: 0x4f, 0x182: 0x50, 0x183: 0x51, 0x184: 0x52, 0x185: 0x53, 0x186: 0x54, 0x187: 0x55,
+	0x188: 0x56, 0x189: 0x57, 0x18a: 0x58, 0x18c: 0x59, 0x18f: 0x5a,
+	0x191: 0x5b, 0x192: 0x5c, 0x193: 0x5d, 0x194: 0x5c, 0x195: 0x5e, 0x196: 0x5f, 0x197: 0x60,
+	0x198: 0x61, 0x199: 0x62, 0x19a: 0x63, 0x19b: 0x64, 0x19c: 0x65, 0x19d: 0x66, 0x19e: 0x67,
+	0x1a4: 0x68,
+	0x1ac: 0x69, 0x1ad: 0x6a,
+	0x1b3: 0x6b, 0x1b5: 0x6c, 0x1b7: 0x6d,
+	0x1ba: 0x6e, 0x1bb: 0x6f, 0x1bc: 0x39, 0x1bd: 0x39, 0x1be: 0x39, 0x1bf: 0x70,
+	// Block 0x7, offset 0x1c0
+	0x1c0: 0x71, 0x1c1: 0x72, 0x1c2: 0x73, 0x1c3: 0x39, 0x1c4: 0x74, 0x1c5: 0x39, 0x1c6: 0x75, 0x1c7: 0x76,
+	0x1c8: 0x77, 0x1c9: 0x78, 0x1ca: 0x79, 0x1cb: 0x39, 0x1cc: 0x39, 0x1cd: 0x39, 0x1ce: 0x39, 0x1cf: 0x39,
+	0x1d0: 0x39, 0x1d1: 0x39, 0x1d2: 0x39, 0x1d3: 0x39, 0x1d4: 0x39, 0x1d5: 0x39, 0x1d6: 0x39, 0x1d7: 0x39,
+	0x1d8: 0x39, 0x1d9: 0x39, 0x1da: 0x39, 0x1db: 0x39, 0x1dc: 0x39, 0x1dd: 0x39, 0x1de: 0x39, 0x1df: 0x39,
+	0x1e0: 0x39, 0x1e1: 0x39, 0x1e2: 0x39, 0x1e3: 0x39, 0x1e4: 0x39, 0x1e5: 0x39, 0x1e6: 0x39, 0x1e7: 0x39,
+	0x1e8: 0x39, 0x1e9: 0x39, 0x1ea: 0x39, 0x1eb: 0x39, 0x1ec: 0x39, 0x1ed: 0x39, 0x1ee: 0x39, 0x1ef: 0x39,
+	0x1f0: 0x39, 0x1f1: 0x39, 0x1f2: 0x39, 0x1f3: 0x39, 0x1f4: 0x39, 0x1f5: 0x39, 0x1f6: 0x39, 0x1f7: 0x39,
+	0x1f8: 0x39, 0x1f9: 0x39, 0x1fa: 0x39, 0x1fb: 0x39, 0x1fc: 0x39, 0x1fd: 0x39, 0x1fe: 0x39, 0x1ff: 0x39,
+	// Block 0x8, offset 0x200
+	0x200: 0x39, 0x201: 0x39, 0x202: 0x39, 0x203: 0x39, 0x204: 0x39, 0x205: 0x39, 0x206: 0x39, 0x207: 0x39,
+	0x208: 0x39, 0x209: 0x39, 0x20a: 0x39, 0x20b: 0x39, 0x20c: 0x39, 0x20d: 0x39, 0x20e: 0x39, 0x20f: 0x39,
+	0x210: 0x39, 0x211: 0x39, 0x212: 0x39, 0x213: 0x39, 0x214: 0x39, 0x215: 0x39, 0x216: 0x39, 0x217: 0x39,
+	0x218: 0x39, 0x219: 0x39, 0x21a: 0x39, 0x21b: 0x39, 0x21c: 0x39, 0x21d: 0x39, 0x21e: 0x39, 0x21f: 0x39,
+	0x220: 0x39, 0x221: 0x39, 0x222: 0x39, 0x223: 0x39, 0x224: 0x39, 0x225: 0x39, 0x226: 0x39, 0x227: 0x39,
+	0x228: 0x39, 0x229: 0x39, 0x22a: 0x39, 0x22b: 0x39, 0x22c: 0x39, 0x22d: 0x39, 0x22e: 0x39, 0x22f: 0x39,
+	0x230: 0x39, 0x231: 0x39, 0x232: 0x39, 0x233: 0x39, 0x234: 0x39, 0x235: 0x39, 0x236: 0x39, 0x237: 0x39,
+	0x238: 0x39, 0x239: 0x39, 0x23a: 0x39, 0x23b: 0x39, 0x23c: 0x39, 0x23d: 0x39, 0x23e: 0x39, 0x23f: 0x39,
+	// Block 0x9, offset 0x240
+	0x240: 0x39, 0x241: 0x39, 0x242: 0x39, 0x243: 0x39, 0x244: 0x39, 0x245: 0x39, 0x246: 0x39, 0x247: 0x39,
+	0x248: 0x39, 0x249: 0x39, 0x24a: 0x39, 0x24b: 0x39, 0x24c: 0x39, 0x24d: 0x39, 0x24e: 0x39, 0x24f: 0x39,
+	0x250: 0x39, 0x251: 0x39, 0x252: 0x7a, 0x253: 0x7b,
+	0x259: 0x7c, 0x25a: 0x7d, 0x25b: 0x7e,
+	0x260: 0x7f, 0x263: 0x80, 0x264: 0x81, 0x265: 0x82, 0x266: 0x83, 0x267: 0x84,
+	0x268: 0x85, 0x269: 0x86, 0x26a: 0x87, 0x26b: 0x88, 0x26f: 0x89,
+	0x270: 0x39, 0x271: 0x39, 0x272: 0x39, 0x273: 0x39, 0x274: 0x39, 0x275: 0x39, 0x276: 0x39, 0x277: 0x39,
+	0x278: 0x39, 0x279: 0x39, 0x27a: 0x39, 0x27b: 0x39, 0x27c: 0x39, 0x27d: 0x39, 0x27e: 0x39, 0x27f: 0x39,
+	// Block 0xa, offset 0x280
+	0x280: 0x39, 0x281: 0x39, 0x282: 0x39, 0x283: 0x39, 0x284: 0x39, 0x285: 0x39, 0x286: 0x39, 0x287: 0x39,
+	0x288: 0x39, 0x289: 0x39, 0x28a: 0x39, 0x28b: 0x39, 0x28c: 0x39, 0x28d: 0x39, 0x28e: 0x39, 0x28f: 0x39,
+	0x290: 0x39, 0x291: 0x39, 0x292: 0x39, 0x293: 0x39, 0x294: 0x39, 0x295: 0x39, 0x296: 0x39, 0x297: 0x39,
+	0x298: 0x39, 0x299: 0x39, 0x29a: 0x39, 0x29b: 0x39, 0x29c: 0x39, 0x29d: 0x39, 0x29e: 0x8a, 0x29f: 0x8b,
+	// Block 0xb, offset 0x2c0
+	0x2c0: 0x5c, 0x2c1: 0x5c, 0x2c2: 0x5c, 0x2c3: 0x5c, 0x2c4: 0x5c, 0x2c5: 0x5c, 0x2c6: 0x5c, 0x2c7: 0x5c,
+	0x2c8: 0x5c, 0x2c9: 0x5c, 0x2ca: 0x5c, 0x2cb: 0x5c, 0x2cc: 0x5c, 0x2cd: 0x5c, 0x2ce: 0x5c, 0x2cf: 0x5c,
+	0x2d0: 0x5c, 0x2d1: 0x5c, 0x2d2: 0x5c, 0x2d3: 0x5c, 0x2d4: 0x5c, 0x2d5: 0x5c, 0x2d6: 0x5c, 0x2d7: 0x5c,
+	0x2d8: 0x5c, 0x2d9: 0x5c, 0x2da: 0x5c, 0x2db: 0x5c, 0x2dc: 0x5c, 0x2dd: 0x5c, 0x2de: 0x5c, 0x2df: 0x5c,
+	0x2e0: 0x5c, 0x2e1: 0x5c, 0x2e2: 0x5c, 0x2e3: 0x5c, 0x2e4: 0x5c, 0x2e5: 0x5c, 0x2e6: 0x5c, 0x2e7: 0x5c,
+	0x2e8: 0x5c, 0x2e9: 0x5c, 0x2ea: 0x5c, 0x2eb: 0x5c, 0x2ec: 0x5c, 0x2ed: 0x5c, 0x2ee: 0x5c, 0x2ef: 0x5c,
+	0x2f0: 0x5c, 0x2f1: 0x5c, 0x2f2: 0x5c, 0x2f3: 0x5c, 0x2f4: 0x5c, 0x2f5: 0x5c, 0x2f6: 0x5c, 0x2f7: 0x5c,
+	0x2f8: 0x5c, 0x2f9: 0x5c, 0x2fa: 0x5c, 0x2fb: 0x5c, 0x2fc: 0x5c, 0x2fd: 0x5c, 0x2fe: 0x5c, 0x2ff: 0x5c,
+	// Block 0xc, offset 0x300
+	0x300: 0x5c, 0x301: 0x5c, 0x302: 0x5c, 0x303: 0x5c, 0x304: 0x5c, 0x305: 0x5c, 0x306: 0x5c, 0x307: 0x5c,
+	0x308: 0x5c, 0x309: 0x5c, 0x30a: 0x5c, 0x30b: 0x5c, 0x30c: 0x5c, 0x30d: 0x5c, 0x30e: 0x5c, 0x30f: 0x5c,
+	0x310: 0x5c, 0x311: 0x5c, 0x312: 0x5c, 0x313: 0x5c, 0x314: 0x5c, 0x315: 0x5c, 0x316: 0x5c, 0x317: 0x5c,
+	0x318: 0x5c, 0x319: 0x5c, 0x31a: 0x5c, 0x31b: 0x5c, 0x31c: 0x5c, 0x31d: 0x5c, 0x31e: 0x5c, 0x31f: 0x5c,
+	0x320: 0x5c, 0x321: 0x5c, 0x322: 0x5c, 0x323: 0x5c, 0x324: 0x39, 0x325: 0x39, 0x326: 0x39, 0x327: 0x39,
+	0x328: 0x39, 0x329: 0x39, 0x32a: 0x39, 0x32b: 0x39, 0x32c: 0x8c,
+	0x338: 0x8d, 0x339: 0x8e, 0x33b: 0x6c, 0x33c: 0x72, 0x33d: 0x8f, 0x33f: 0x90,
+	// Block 0xd, offset 0x340
+	0x347: 0x91,
+	0x34b: 0x92, 0x34d: 0x93,
+	0x368: 0x94, 0x36b: 0x95,
+	0x374: 0x96, 0x375: 0x97,
+	0x37a: 0x98, 0x37b: 0x99, 0x37d: 0x9a, 0x37e: 0x9b,
+	// Block 0xe, offset 0x380
+	0x380: 0x9c, 0x381: 0x9d, 0x382: 0x9e, 0x383: 0x9f, 0x384: 0xa0, 0x385: 0xa1, 0x386: 0xa2, 0x387: 0xa3,
+	0x388: 0xa4, 0x389: 0x2c, 0x38b: 0xa5, 0x38c: 0x2a, 0x38d: 0xa6, 0x38e: 0xa7, 0x38f: 0xa8,
+	0x390: 0xa9, 0x391: 0xaa, 0x392: 0xab, 0x393: 0xac, 0x396: 0xad, 0x397: 0xae,
+	0x398: 0xaf, 0x399: 0xb0, 0x39a: 0xb1, 0x39c: 0xb2,
+	0x3a0: 0xb3, 0x3a4: 0xb4, 0x3a5: 0xb5, 0x3a7: 0xb6,
+	0x3a8: 0xb7, 0x3a9: 0xb8, 0x3aa: 0xb9, 0x3ad: 0xba,
+	0x3b0: 0xbb, 0x3b2: 0xbc, 0x3b4: 0xbd, 0x3b5: 0xbe, 0x3b6: 0xbf,
+	0x3bb: 0xc0, 0x3bc: 0xc1, 0x3bd: 0xc2,
+	// Block 0xf, offset 0x3c0
+	0x3d0: 0x46, 0x3d1: 0xc3,
+	// Block 0x10, offset 0x400
+	0x404: 0xc4,
+	0x42b: 0xc5, 0x42c: 0xc6,
+	0x43d: 0xc7, 0x43e: 0xc8, 0x43f: 0xc9,
+	// Block 0x11, offset 0x440
+	0x440: 0x39, 0x441: 0x39, 0x442: 0x39, 0x443: 0x39, 0x444: 0x39, 0x445: 0x39, 0x446: 0x39, 0x447: 0x39,
+	0x448: 0x39, 0x449: 0x39, 0x44a: 0x39, 0x44b: 0x39, 0x44c: 0x39, 0x44d: 0x39, 0x44e: 0x39, 0x44f: 0x39,
+	0x450: 0x39, 0x451: 0x39, 0x452: 0x39, 0x453: 0x39, 0x454: 0x39, 0x455: 0x39, 0x456: 0x39, 0x457: 0x39,
+	0x458: 0x39, 0x459: 0x39, 0x45a: 0x39, 0x45b: 0x39, 0x45c: 0x39, 0x45d: 0x39, 0x45e: 0x39, 0x45f: 0x39,
+	0x460: 0x39, 0x461: 0x39, 0x462: 0x39, 0x463: 0x39, 0x464: 0x39, 0x465: 0x39, 0x466: 0x39, 0x467: 0x39,
+	0x468: 0x39, 0x469: 0x39, 0x46a: 0x39, 0x46b: 0x39, 0x46c: 0x39, 0x46d: 0x39, 0x46e: 0x39, 0x46f: 0x39,
+	0x470: 0x39, 0x471: 0x39, 0x472: 0x39, 0x473: 0xca, 0x474: 0xcb, 0x476: 0x39, 0x477: 0xcc,
+	// Block 0x12, offset 0x480
+	0x4bf: 0xcd,
+	// Block 0x13, offset 0x4c0
+	0x4c0: 0x39, 0x4c1: 0x39, 0x4c2: 0x39, 0x4c3: 0x39, 0x4c4: 0xce, 0x4c5: 0xcf, 0x4c6: 0x39, 0x4c7: 0x39,
+	0x4c8: 0x39, 0x4c9: 0x39, 0x4ca: 0x39, 0x4cb: 0xd0,
+	0x4f2: 0xd1,
+	// Block 0x14, offset 0x500
+	0x53c: 0xd2, 0x53d: 0xd3,
+	// Block 0x15, offset 0x540
+	0x545: 0xd4, 0x546: 0xd5,
+	0x549: 0xd6, 0x54c: 0x39, 0x54d: 0xd7,
+	0x568: 0xd8, 0x569: 0xd9, 0x56a: 0xda,
+	// Block 0x16, offset 0x580
+	0x580: 0xdb, 0x582: 0xc7, 0x584: 0xc6,
+	0x58a: 0xdc, 0x58b: 0xdd,
+	0x593: 0xdd, 0x597: 0xde,
+	0x59b: 0xdf,
+	0x5a3: 0xe0, 0x5a5: 0xe1,
+	// Block 0x17, offset 0x5c0
+	0x5c0: 0xe2, 0x5c3: 0xe3, 0x5c4: 0xe4, 0x5c5: 0xe5, 0x5c6: 0xe6, 0x5c7: 0xe7,
+	0x5c8: 0xe8, 0x5c9: 0xe9, 0x5cc: 0xea, 0x5cd: 0xeb, 0x5ce: 0xec, 0x5cf: 0xed,
+	0x5d0: 0xee, 0x5d1: 0xef, 0x5d2: 0xf0, 0x5d3: 0xf1, 0x5d4: 0xf2, 0x5d5: 0xf3, 0x5d6: 0xf4, 0x5d7: 0xf5,
+	0x5d8: 0xf0, 0x5d9: 0xf6, 0x5da: 0xf0, 0x5db: 0xf7, 0x5df: 0xf8,
+	0x5e4: 0xf9, 0x5e5: 0xfa, 0x5e6: 0xf0, 0x5e7: 0xf0,
+	0x5e9: 0xfb, 0x5ea: 0xfc, 0x5eb: 0xfd,
+	// Block 0x18, offset 0x600
+	0x600: 0x39, 0x601: 0x39, 0x602: 0x39, 0x603: 0x39, 0x604: 0x39, 0x605: 0x39, 0x606: 0x39, 0x607: 0x39,
+	0x608: 0x39, 0x609: 0x39, 0x60a: 0x39, 0x60b: 0x39, 0x60c: 0x39, 0x60d: 0x39, 0x60e: 0x39, 0x60f: 0x39,
+	0x610: 0x39, 0x611: 0x39, 0x612: 0x39, 0x613: 0x39, 0x614: 0x39, 0x615: 0x39, 0x616: 0x39, 0x617: 0x39,
+	0x618: 0x39, 0x619: 0x39, 0x61a: 0x39, 0x61b: 0x39, 0x61c: 0x39, 0x61d: 0x39, 0x61e: 0x39, 0x61f: 0x39,
+	0x620: 0x39, 0x621: 0x39, 0x622: 0x39, 0x623: 0x39, 0x624: 0x39, 0x625: 0x39, 0x626: 0x39, 0x627: 0x39,
+	0x628: 0x39, 0x629: 0x39, 0x62a: 0x39, 0x62b: 0x39, 0x62c: 0x39, 0x62d: 0x39, 0x62e: 0x39, 0x62f: 0x39,
+	0x630: 0x39, 0x631: 0x39, 0x632: 0x39, 0x633: 0x39, 0x634: 0x39, 0x635: 0x39, 0x636: 0x39, 0x637: 0x39,
+	0x638: 0x39, 0x639: 0x39, 0x63a: 0x39, 0x63b: 0x39, 0x63c: 0x39, 0x63d: 0x39, 0x63e: 0x39, 0x63f: 0xfe,
+	// Block 0x19, offset 0x640
+	0x650: 0x0b, 0x651: 0x0c, 0x653: 0x0d, 0x656: 0x0e, 0x657: 0x06,
+	0x658: 0x0f, 0x65a: 0x10, 0x65b: 0x11, 0x65c: 0x12, 0x65d: 0x13, 0x65e: 0x14, 0x65f: 0x15,
+	0x660: 0x06, 0x661: 0x06, 0x662: 0x06, 0x663: 0x06, 0x664: 0x06, 0x665: 0x06, 0x666: 0x06, 0x667: 0x06,
+	0x668: 0x06, 0x669: 0x06, 0x66a: 0x06, 0x66b: 0x06, 0x66c: 0x06, 0x66d: 0x06, 0x66e: 0x06, 0x66f: 0x16,
+	0x670: 0x06, 0x671: 0x06, 0x672: 0x06, 0x673: 0x06, 0x674: 0x06, 0x675: 0x06, 0x676: 0x06, 0x677: 0x06,
+	0x678: 0x06, 0x679: 0x06, 0x67a: 0x06, 0x67b: 0x06, 0x67c: 0x06, 0x67d: 0x06, 0x67e: 0x06, 0x67f: 0x16,
+	// Block 0x1a, offset 0x680
+	0x680: 0xff, 0x681: 0x3b, 0x684: 0x08, 0x685: 0x08, 0x686: 0x08, 0x687: 0x09,
+	// Block 0x1b, offset 0x6c0
+	0x6c0: 0x5c, 0x6c1: 0x5c, 0x6c2: 0x5c, 0x6c3: 0x5c, 0x6c4: 0x5c, 0x6c5: 0x5c, 0x6c6: 0x5c, 0x6c7: 0x5c,
+	0x6c8: 0x5c, 0x6c9: 0x5c, 0x6ca: 0x5c, 0x6cb: 0x5c, 0x6cc: 0x5c, 0x6cd: 0x5c, 0x6ce: 0x5c, 0x6cf: 0x5c,
+	0x6d0: 0x5c, 0x6d1: 0x5c, 0x6d2: 0x5c, 0x6d3: 0x5c, 0x6d4: 0x5c, 0x6d5: 0x5c, 0x6d6: 0x5c, 0x6d7: 0x5c,
+	0x6d8: 0x5c, 0x6d9: 0x5c, 0x6da: 0x5c, 0x6db: 0x5c, 0x6dc: 0x5c, 0x6dd: 0x5c, 0x6de: 0x5c, 0x6df: 0x5c,
+	0x6e0: 0x5c, 0x6e1: 0x5c, 0x6e2: 0x5c, 0x6e3: 0x5c, 0x6e4: 0x5c, 0x6e5: 0x5c, 0x6e6: 0x5c, 0x6e7: 0x5c,
+	0x6e8: 0x5c, 0x6e9: 0x5c, 0x6ea: 0x5c, 0x6eb: 0x5c, 0x6ec: 0x5c, 0x6ed: 0x5c, 0x6ee: 0x5c, 0x6ef: 0x5c,
+	0x6f0: 0x5c, 0x6f1: 0x5c, 0x6f2: 0x5c, 0x6f3: 0x5c, 0x6f4: 0x5c, 0x6f5: 0x5c, 0x6f6: 0x5c, 0x6f7: 0x5c,
+	0x6f8: 0x5c, 0x6f9: 0x5c, 0x6fa: 0x5c, 0x6fb: 0x5c, 0x6fc: 0x5c, 0x6fd: 0x5c, 0x6fe: 0x5c, 0x6ff: 0x100,
+	// Block 0x1c, offset 0x700
+	0x720: 0x18,
+	0x730: 0x09, 0x731: 0x09, 0x732: 0x09, 0x733: 0x09, 0x734: 0x09, 0x735: 0x09, 0x736: 0x09, 0x737: 0x09,
+	0x738: 0x09, 0x739: 0x09, 0x73a: 0x09, 0x73b: 0x09, 0x73c: 0x09, 0x73d: 0x09, 0x73e: 0x09, 0x73f: 0x19,
+	// Block 0x1d, offset 0x740
+	0x740: 0x09, 0x741: 0x09, 0x742: 0x09, 0x743: 0x09, 0x744: 0x09, 0x745: 0x09, 0x746: 0x09, 0x747: 0x09,
+	0x748: 0x09, 0x749: 0x09, 0x74a: 0x09, 0x74b: 0x09, 0x74c: 0x09, 0x74d: 0x09, 0x74e: 0x09, 0x74f: 0x19,
+}
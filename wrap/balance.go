@@ -0,0 +1,165 @@
+package wrap
+
+import "iter"
+
+// Balance returns the lines of s, wrapped to width in terminal columns but
+// balanced, as by [Balanced]. It is shorthand for
+// NewBalanced(float64(width)).Wrap(s).
+func Balance(s string, width int) []string {
+	return AppendBalance(nil, s, width)
+}
+
+// AppendBalance appends the lines of s, balanced as by [Balance], to dst,
+// and returns the extended slice.
+func AppendBalance(dst []string, s string, width int) []string {
+	return NewBalanced(float64(width)).AppendWrap(dst, s)
+}
+
+// BalanceLines returns an iterator over the lines of s, balanced as by
+// [Balance].
+func BalanceLines(s string, width int) iter.Seq[Line] {
+	return NewBalanced(float64(width)).WrapLines(s)
+}
+
+// Balanced is wrapping as by [Greedy], but balanced, as in CSS text-wrap:
+// balance, so that a heading or title which wraps does not leave one word
+// alone on its last line. Each paragraph, up to a mandatory break, is
+// wrapped greedily to the narrowest width which takes as few lines as
+// wrapping to width, so that its lines are of about the same width. Runs
+// which do not fit on a line are split as by [Wrap] at width, so that
+// there are as many lines as by Wrap.
+//
+// Balanced finds the opportunities of s once, and then the narrowest width
+// by binary search, in O(n log w) time for n opportunities and a width
+// of w.
+//
+// Balanced is a value; each With method returns a copy.
+type Balanced struct {
+	width    float64
+	measurer Measurer
+}
+
+// NewBalanced returns balanced wrapping to width, in terminal columns
+// unless measured otherwise. A width which is not positive is treated as
+// the narrowest possible, so that each line holds one grapheme cluster.
+func NewBalanced(width float64) Balanced {
+	return Balanced{width: positive(width)}
+}
+
+// WithMeasurer returns a copy of b, which measures widths with m. A nil m
+// restores the default, [Columns].
+func (b Balanced) WithMeasurer(m Measurer) Balanced {
+	b.measurer = m
+	return b
+}
+
+// Wrap returns the lines of s, without trailing spaces or line terminators.
+// A line terminator at the end of s does not begin another line.
+func (b Balanced) Wrap(s string) []string {
+	return b.AppendWrap(nil, s)
+}
+
+// AppendWrap appends the lines of s, as by [Balanced.Wrap], to dst, and
+// returns the extended slice.
+func (b Balanced) AppendWrap(dst []string, s string) []string {
+	for line := range b.WrapLines(s) {
+		dst = append(dst, line.Text)
+	}
+	return dst
+}
+
+// WrapLines returns an iterator over the lines of s, as by [Balanced.Wrap].
+func (b Balanced) WrapLines(s string) iter.Seq[Line] {
+	width := positive(b.width)
+	return func(yield func(Line) bool) {
+		segs := segments(s, width, measurerOr(b.measurer), false)
+		var breaks []int
+		for len(segs) > 0 {
+			para := paragraph(segs)
+			segs = segs[len(para):]
+			para = indent(para, width)
+
+			breaks = balance(para, width, breaks[:0])
+			start := 0
+			for _, end := range breaks {
+				if !yield(line(s, para[start:end])) {
+					return
+				}
+				start = end
+			}
+		}
+	}
+}
+
+// balance appends the breaks of the lines of a paragraph, filled greedily
+// to the narrowest width which takes as few lines as width, to breaks, and
+// returns the extended slice.
+func balance(para []segment, width float64, breaks []int) []int {
+	best := fill(para, width, breaks)
+	lines := len(best)
+
+	// The narrowest width is no narrower than the widest segment, and is
+	// the width of the widest line at that width; so hi is always such a
+	// width, of which there are finitely many, and the search ends. Where
+	// a segment is no narrower than width, it is split as at width, and
+	// no narrower width is sought
+	lo := 0.0
+	for _, seg := range para {
+		lo = max(lo, seg.width)
+	}
+	if lo >= width {
+		return best
+	}
+	var b []int
+	if b = fill(para, lo, b); len(b) <= lines {
+		return b
+	}
+	hi := widest(para, best)
+	for range 64 {
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi {
+			break
+		}
+		if b = fill(para, mid, b[:0]); len(b) <= lines {
+			best, b = b, best
+			hi = widest(para, best)
+		} else {
+			lo = mid
+		}
+	}
+	return best
+}
+
+// fill appends the breaks of the lines of a paragraph, filled greedily to
+// width, to breaks, and returns the extended slice. Each break is the
+// number of segments before it. As by [Greedy], a run which is split
+// begins a line, and each of its pieces but the last ends one, so that at
+// the width the segments were split to, the lines are those of Greedy.
+func fill(para []segment, width float64, breaks []int) []int {
+	start := 0
+	w := 0.0 // width of para[start:i], including trailing spaces
+	for i, seg := range para {
+		if i > start && (w+seg.width > width || seg.overflow || para[i-1].emergency) {
+			breaks = append(breaks, i)
+			start = i
+			w = 0
+		}
+		w += seg.width + seg.space
+	}
+	if len(para) > 0 {
+		breaks = append(breaks, len(para))
+	}
+	return breaks
+}
+
+// widest returns the width of the widest of the lines of a paragraph with
+// the given breaks.
+func widest(para []segment, breaks []int) float64 {
+	m, start := 0.0, 0
+	for _, end := range breaks {
+		_, w := measureLine(para[start:end])
+		m = max(m, w)
+		start = end
+	}
+	return m
+}
@@ -0,0 +1,159 @@
+package wrap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBalance(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  []string
+	}{
+		{
+			name:  "empty",
+			in:    "",
+			width: 20,
+			want:  nil,
+		},
+		{
+			name:  "fits",
+			in:    "Hello world",
+			width: 20,
+			want:  []string{"Hello world"},
+		},
+		{
+			name:  "one word alone",
+			in:    "aaaa bbbb cccc dddd e",
+			width: 20,
+			want:  []string{"aaaa bbbb", "cccc dddd e"},
+		},
+		{
+			name:  "three lines",
+			in:    "The quick brown fox jumps over the lazy dog",
+			width: 20,
+			want:  []string{"The quick brown", "fox jumps over", "the lazy dog"},
+		},
+		{
+			name:  "paragraphs",
+			in:    "Settings and preferences for your account\nShort",
+			width: 20,
+			want:  []string{"Settings and", "preferences for", "your account", "Short"},
+		},
+		{
+			name:  "wide characters",
+			in:    "日本語のテキストです",
+			width: 16,
+			want:  []string{"日本語のテ", "キストです"},
+		},
+		{
+			name:  "emergency split",
+			in:    "see abcdefghijkl x",
+			width: 5,
+			want:  []string{"see", "abcde", "fghij", "kl x"},
+		},
+		{
+			name:  "emergency split after a segment",
+			in:    "000000!00 !",
+			width: 3,
+			want:  []string{"000", "000", "!", "00", "!"},
+		},
+		{
+			name:  "zero width",
+			in:    "00\u6587",
+			width: 0,
+			want:  []string{"0", "0", "\u6587"},
+		},
+		{
+			name:  "indented",
+			in:    "  aa bb",
+			width: 5,
+			want:  []string{"  aa", "bb"},
+		},
+		{
+			name:  "segment wider than width",
+			in:    "aa \u0301\u4E2D",
+			width: 1,
+			want:  []string{"a", "a", "\u0301", "\u4E2D"},
+		},
+		{
+			name:  "trailing tab",
+			in:    "hello world \t",
+			width: 11,
+			want:  []string{"hello world"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Balance(tt.in, tt.width)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			if len(got) != len(Wrap(tt.in, tt.width)) {
+				t.Fatalf("got %d lines, want %d", len(got), len(Wrap(tt.in, tt.width)))
+			}
+		})
+	}
+}
+
+func TestFill_Greedy(t *testing.T) {
+	// At the width the segments were split to, fill is Greedy
+	for _, in := range []string{
+		"000000!00 !",
+		"see abcdefghijkl x",
+		"  aa bb\n  0000 a\n\nb",
+		"The quick brown fox jumps over the lazy dog",
+		"00\u6587 \u65E5\u672C\u8A9E",
+	} {
+		for width := 0; width <= 8; width++ {
+			want := Wrap(in, width)
+			var got []string
+			w := positive(float64(width))
+			segs := segments(in, w, Columns{}, false)
+			for len(segs) > 0 {
+				para := paragraph(segs)
+				segs = segs[len(para):]
+				para = indent(para, w)
+				start := 0
+				for _, end := range fill(para, w, nil) {
+					got = append(got, line(in, para[start:end]).Text)
+					start = end
+				}
+			}
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", want) {
+				t.Errorf("%q at %d: got %q, want %q", in, width, got, want)
+			}
+		}
+	}
+}
+
+func TestBalanceLines(t *testing.T) {
+	var got []Line
+	for line := range BalanceLines("ab cd e", 5) {
+		got = append(got, line)
+	}
+	want := []Line{
+		{Text: "ab", Width: 2, Start: 0, End: 3},
+		{Text: "cd e", Width: 4, Start: 3, End: 7, Mandatory: true},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestLine_TrailingSpace(t *testing.T) {
+	// A segment of white space alone, at the end of a line, is trailing
+	// space of the segment before it
+	segs := []segment{
+		{start: 0, content: 5, end: 6, width: 5, space: 1},
+		{start: 6, content: 6, end: 7, space: 1, mandatory: true},
+	}
+	got := line("hello \t", segs)
+	want := Line{Text: "hello", Width: 5, Start: 0, End: 7, Mandatory: true}
+	if got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package wrap
+
+import (
+	"math"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/uax14"
+)
+
+// Measurer measures the advance width of text, such as in terminal columns,
+// or in points for a proportional font. Wrapping measures each segment
+// between opportunities without its trailing spaces, and the spaces
+// separately, so that spaces at the end of a line may hang past the margin;
+// the width of a line is the sum of those of its segments and the spaces
+// between them.
+//
+// Measure may be called for any substring of the text, including partial
+// segments, when splitting a run which does not fit on a line. Its result
+// should not decrease as text is appended.
+type Measurer interface {
+	Measure(text string) float64
+}
+
+// MeasureFunc adapts an ordinary function to the [Measurer] interface.
+type MeasureFunc func(text string) float64
+
+// Measure calls f(text).
+func (f MeasureFunc) Measure(text string) float64 {
+	return f(text)
+}
+
+// Columns is a [Measurer] of terminal columns, the default, as by
+// [uax14.Widths]: wide and fullwidth characters, such as ideographs, and
+// emoji are two columns, and combining marks and control characters, none.
+//
+// Columns is a value; each With method returns a copy.
+type Columns struct {
+	widths uax14.Widths
+}
+
+// WithAmbiguousWidth returns a copy of c, which measures characters whose
+// East_Asian_Width is Ambiguous as width columns, 1 or 2, as by
+// [uax14.Widths.WithAmbiguousWidth].
+func (c Columns) WithAmbiguousWidth(width int) Columns {
+	c.widths = c.widths.WithAmbiguousWidth(width)
+	return c
+}
+
+// Measure implements [Measurer].
+func (c Columns) Measure(text string) float64 {
+	return float64(c.widths.Width(text))
+}
+
+// measureUpTo returns the width of text, measured by m, or, if that is more
+// than limit, some width which is more than limit. It measures prefixes of
+// increasing length first, so that a long run, which is split into many
+// lines, is not measured whole for each of them.
+func measureUpTo(m Measurer, text string, limit float64) float64 {
+	for n := 64; n < len(text); n *= 2 {
+		end := n
+		for end < len(text) && !utf8.RuneStart(text[end]) {
+			end++
+		}
+		if w := m.Measure(text[:end]); w > limit {
+			return w
+		}
+	}
+	return m.Measure(text)
+}
+
+// measurerOr returns m, or [Columns] if m is nil.
+func measurerOr(m Measurer) Measurer {
+	if m == nil {
+		return Columns{}
+	}
+	return m
+}
+
+// positive returns width, or the least positive width if width is not
+// positive, so that each line holds one grapheme cluster.
+func positive(width float64) float64 {
+	if width > 0 {
+		return width
+	}
+	return math.SmallestNonzeroFloat64
+}
@@ -0,0 +1,135 @@
+package wrap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// narrow measures i, l and space as half an em, and other runes as one
+var narrow = MeasureFunc(func(text string) float64 {
+	w := 0.0
+	for _, r := range text {
+		switch r {
+		case 'i', 'l', ' ':
+			w += 0.5
+		default:
+			w++
+		}
+	}
+	return w
+})
+
+func TestMeasurer(t *testing.T) {
+	const in = "will mill ill ill lilt"
+	tests := []struct {
+		name string
+		wrap interface{ Wrap(string) []string }
+		want []string
+	}{
+		{
+			name: "greedy",
+			wrap: NewGreedy(5.5).WithMeasurer(narrow),
+			want: []string{"will mill", "ill ill", "lilt"},
+		},
+		{
+			name: "optimal",
+			wrap: NewOptimal(5.5).WithMeasurer(narrow),
+			want: []string{"will mill", "ill ill", "lilt"},
+		},
+		{
+			name: "balanced",
+			wrap: NewBalanced(5.5).WithMeasurer(narrow),
+			want: []string{"will", "mill ill", "ill lilt"},
+		},
+		{
+			name: "pretty",
+			wrap: NewPretty(5.5).WithMeasurer(narrow),
+			want: []string{"will", "mill ill", "ill lilt"},
+		},
+		{
+			name: "columns",
+			wrap: NewGreedy(5.5),
+			want: []string{"will", "mill", "ill", "ill", "lilt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.wrap.Wrap(in)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			for _, line := range got {
+				if w := narrow(line); w > 5.5 && tt.name != "columns" {
+					t.Fatalf("line %q is %v wide", line, w)
+				}
+			}
+		})
+	}
+}
+
+func TestMeasurer_Lines(t *testing.T) {
+	// Trailing spaces hang past the margin
+	var got []Line
+	for line := range NewGreedy(2.5).WithMeasurer(narrow).WrapLines("ill   mi") {
+		got = append(got, line)
+	}
+	want := []Line{
+		{Text: "ill", Width: 1.5, Start: 0, End: 6},
+		{Text: "mi", Width: 1.5, Start: 6, End: 8, Mandatory: true},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestMeasurer_LongRun(t *testing.T) {
+	// A run which is split into many lines is not measured whole for each
+	s := strings.Repeat("0", 1<<15)
+	measured := 0
+	m := MeasureFunc(func(text string) float64 {
+		measured += len(text)
+		return float64(len(text))
+	})
+	tests := []struct {
+		name string
+		wrap interface{ Wrap(string) []string }
+	}{
+		{"greedy", NewGreedy(3).WithMeasurer(m)},
+		{"optimal", NewOptimal(3).WithMeasurer(m)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			measured = 0
+			if got := tt.wrap.Wrap(s); len(got) != len(s)/3+1 {
+				t.Fatalf("got %d lines, want %d", len(got), len(s)/3+1)
+			}
+			if measured > 100*len(s) {
+				t.Fatalf("measured %d bytes, for %d bytes of text", measured, len(s))
+			}
+		})
+	}
+}
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns Columns
+		in      string
+		want    float64
+	}{
+		{"ascii", Columns{}, "abc", 3},
+		{"wide", Columns{}, "日本", 4},
+		{"ZWJ sequence", Columns{}, "\U0001F468\u200D\U0001F4BB", 2},
+		{"ambiguous", Columns{}, "±a", 2},
+		{"ambiguous wide", Columns{}.WithAmbiguousWidth(2), "±a", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.columns.Measure(tt.in); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package wrap
+
+import (
+	"iter"
+	"math"
+	"slices"
+)
+
+// Optimal is optimal-fit wrapping, in the style of Knuth and Plass: rather
+// than filling each line in turn, it chooses among all of the opportunities
+// of a paragraph those which minimize the total demerits of its lines,
+// giving a less ragged right margin. A paragraph is text up to a mandatory
+// break, such as a newline.
+//
+// The demerits of a line are (l + b)² + p², where l is the line penalty,
+// 10; b is the badness of the line, 100·(s/w)³ for slack s on a line of
+// width w, or 0 for the last line of a paragraph; and p is the penalty of
+// the break at its end. Two lines in a row which end at hyphens add further
+// demerits. A run which does not fit on the line it is placed on is split
+// between grapheme clusters, at a penalty of 1000 for each break, so that
+// with [Optimal.WithWidths], a run is only split on a line too narrow for
+// it.
+//
+// Where every line of greedy wrapping fits exactly, other than the last of
+// each paragraph, and no break is penalized, the lines are the same as
+// those of [Wrap].
+//
+// Optimal is a value; each With method returns a copy.
+type Optimal struct {
+	// widths are the widths of the lines of a paragraph, the last
+	// repeating
+	widths []float64
+	// hyphenPenalty is the penalty of a break after a hyphen
+	hyphenPenalty int
+	// doubleHyphenDemerits are added for consecutive lines which end at
+	// hyphens
+	doubleHyphenDemerits int
+	// looseness is the number of lines to add to, or remove from, each
+	// paragraph, if possible
+	looseness int
+	measurer  Measurer
+}
+
+const (
+	// linePenalty is added to the badness of each line, so that fewer lines
+	// are preferred
+	linePenalty = 10
+	// emergencyPenalty is the penalty of a break within an unbreakable run
+	emergencyPenalty = 1000
+	// overfull is the badness of a line which is too wide
+	overfull = 10000
+)
+
+// NewOptimal returns optimal-fit wrapping to width, in terminal columns
+// unless measured otherwise, with a hyphen penalty of 50 and consecutive
+// hyphen demerits of 3000. A width which is not positive is treated as the
+// narrowest possible, so that each line holds one grapheme cluster.
+func NewOptimal(width float64) Optimal {
+	return Optimal{
+		widths:               []float64{positive(width)},
+		hyphenPenalty:        50,
+		doubleHyphenDemerits: 3000,
+	}
+}
+
+// WithWidths returns a copy of o, with the widths of the lines of each
+// paragraph, for shapes and indents: the first line is widths[0] wide, and
+// so on, the last width applying to any further lines. Widths which are not
+// positive are treated as the narrowest possible.
+//
+// WithWidths panics if there are no widths.
+func (o Optimal) WithWidths(widths ...float64) Optimal {
+	if len(widths) == 0 {
+		panic("wrap: no widths")
+	}
+	o.widths = make([]float64, len(widths))
+	for i, w := range widths {
+		o.widths[i] = positive(w)
+	}
+	return o
+}
+
+// WithMeasurer returns a copy of o, which measures widths with m. A nil m
+// restores the default, [Columns].
+func (o Optimal) WithMeasurer(m Measurer) Optimal {
+	o.measurer = m
+	return o
+}
+
+// WithHyphenPenalty returns a copy of o, with the given penalty for a break
+// after a hyphen, including a soft hyphen or hyphenation point. A higher
+// penalty avoids such breaks; a negative one favors them.
+func (o Optimal) WithHyphenPenalty(penalty int) Optimal {
+	o.hyphenPenalty = penalty
+	return o
+}
+
+// WithConsecutiveHyphenDemerits returns a copy of o, with the given
+// demerits for two lines in a row which end at hyphens.
+func (o Optimal) WithConsecutiveHyphenDemerits(demerits int) Optimal {
+	o.doubleHyphenDemerits = demerits
+	return o
+}
+
+// WithLooseness returns a copy of o, which makes each paragraph looseness
+// lines longer than optimal, or shorter if looseness is negative, as near
+// as possible, as TeX's \looseness. Among the breaks which do so, those of
+// the fewest demerits are chosen.
+//
+// A looseness other than zero keeps the best breaks at each opportunity for
+// each number of lines, up to |looseness| + 2 more than the fewest there,
+// rather than only the best, so that its cost grows with |looseness|.
+func (o Optimal) WithLooseness(looseness int) Optimal {
+	o.looseness = looseness
+	return o
+}
+
+// Wrap returns the lines of s, without trailing spaces or line terminators.
+// A line terminator at the end of s does not begin another line.
+func (o Optimal) Wrap(s string) []string {
+	return o.AppendWrap(nil, s)
+}
+
+// AppendWrap appends the lines of s, as by [Optimal.Wrap], to dst, and
+// returns the extended slice.
+func (o Optimal) AppendWrap(dst []string, s string) []string {
+	for line := range o.WrapLines(s) {
+		dst = append(dst, line.Text)
+	}
+	return dst
+}
+
+// WrapLines returns an iterator over the lines of s, as by [Optimal.Wrap].
+func (o Optimal) WrapLines(s string) iter.Seq[Line] {
+	if len(o.widths) == 0 {
+		o = NewOptimal(0).WithMeasurer(o.measurer)
+	}
+	return func(yield func(Line) bool) {
+		segs := segments(s, slices.Min(o.widths), measurerOr(o.measurer), true)
+		for len(segs) > 0 {
+			para := paragraph(segs)
+			segs = segs[len(para):]
+			para = indent(para, o.width(0))
+
+			start := 0
+			for _, end := range o.breaks(para) {
+				if !yield(line(s, para[start:end])) {
+					return
+				}
+				start = end
+			}
+		}
+	}
+}
+
+// width returns the width of the line numbered n, from 0.
+func (o Optimal) width(n int) float64 {
+	return o.widths[min(n, len(o.widths)-1)]
+}
+
+// node is a feasible break, after segs[pos-1], which ends the line numbered
+// line-1.
+type node struct {
+	pos, line int
+	demerits  float64
+	hyphen    bool
+	prev      *node
+}
+
+// breaks returns the positions of the breaks of the lines of a paragraph,
+// each the number of segments before it, in increasing order.
+func (o Optimal) breaks(para []segment) []int {
+	// offsets[i] is the width of para[:i], including trailing spaces
+	offsets := make([]float64, len(para)+1)
+	for i, seg := range para {
+		offsets[i+1] = offsets[i] + seg.width + seg.space
+	}
+
+	active := []*node{{}}
+	// best are the best nodes at a position, by key, reused across
+	// positions, and keys those in use
+	var best []*node
+	var keys []int
+	for pos := 1; pos <= len(para); pos++ {
+		seg := para[pos-1]
+		last := pos == len(para)
+
+		// Nodes which remain active, filtered in place
+		kept := active[:0]
+		for _, a := range active {
+			w := offsets[pos] - offsets[a.pos] - seg.space
+			lw := o.width(a.line)
+			if w > lw && pos > a.pos+1 {
+				// Too wide, as are all later lines from a
+				continue
+			}
+			kept = append(kept, a)
+
+			badness := 0.0
+			switch {
+			case w > lw:
+				badness = overfull
+			case !last:
+				badness = 100 * math.Pow((lw-w)/lw, 3)
+			}
+			demerits := math.Pow(linePenalty+badness, 2)
+			penalty := 0
+			switch {
+			case last:
+			case seg.emergency:
+				penalty = emergencyPenalty
+			case seg.hyphen:
+				penalty = o.hyphenPenalty
+				if a.hyphen {
+					demerits += float64(o.doubleHyphenDemerits)
+				}
+			}
+			if penalty >= 0 {
+				demerits += float64(penalty * penalty)
+			} else {
+				demerits -= float64(penalty * penalty)
+			}
+
+			n := &node{
+				pos:      pos,
+				line:     a.line + 1,
+				demerits: a.demerits + demerits,
+				hyphen:   seg.hyphen && !last,
+				prev:     a,
+			}
+			k := o.key(n.line)
+			for len(best) <= k {
+				best = append(best, nil)
+			}
+			if best[k] == nil {
+				keys = append(keys, k)
+				best[k] = n
+			} else if n.demerits < best[k].demerits {
+				best[k] = n
+			}
+		}
+
+		active = kept
+		slices.Sort(keys)
+		for _, k := range keys {
+			if k <= keys[0]+o.spread() {
+				active = append(active, best[k])
+			}
+			best[k] = nil
+		}
+		if last {
+			return o.choose(active[len(kept):])
+		}
+		keys = keys[:0]
+	}
+	return nil
+}
+
+// key returns the key of nodes which end a line, by which only the best
+// nodes at a position are kept. Where lines are of the same width from
+// there on, and the number of lines does not matter, only one node is
+// kept.
+func (o Optimal) key(line int) int {
+	if o.looseness != 0 {
+		return line
+	}
+	return min(line, len(o.widths)-1)
+}
+
+// spread returns how many more lines than the fewest, at a position, the
+// nodes kept there may end. Without looseness, keys are few, and all are
+// kept; with it, only line counts which looseness may reach are, so that a
+// long paragraph does not keep a node for every count.
+func (o Optimal) spread() int {
+	if o.looseness == 0 {
+		return len(o.widths)
+	}
+	return abs(o.looseness) + 2
+}
+
+// choose returns the breaks of the best of the nodes at the end of a
+// paragraph, per o.looseness.
+func (o Optimal) choose(nodes []*node) []int {
+	var best *node
+	for _, n := range nodes {
+		if best == nil || n.demerits < best.demerits || n.demerits == best.demerits && n.line < best.line {
+			best = n
+		}
+	}
+	if o.looseness != 0 {
+		target := best.line + o.looseness
+		for _, n := range nodes {
+			d, bd := abs(n.line-target), abs(best.line-target)
+			if d < bd || d == bd && n.demerits < best.demerits {
+				best = n
+			}
+		}
+	}
+
+	breaks := make([]int, best.line)
+	for n := best; n.prev != nil; n = n.prev {
+		breaks[n.line-1] = n.pos
+	}
+	return breaks
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
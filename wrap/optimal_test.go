@@ -0,0 +1,189 @@
+package wrap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestOptimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		optimal Optimal
+		want    []string
+	}{
+		{
+			name:    "empty",
+			in:      "",
+			optimal: NewOptimal(10),
+			want:    nil,
+		},
+		{
+			name:    "less ragged than greedy",
+			in:      "aaa bb cc ddddd",
+			optimal: NewOptimal(6),
+			want:    []string{"aaa", "bb cc", "ddddd"},
+		},
+		{
+			name:    "hyphen penalty",
+			in:      "aaa bbb-ccc ddd",
+			optimal: NewOptimal(8),
+			want:    []string{"aaa", "bbb-ccc", "ddd"},
+		},
+		{
+			name:    "no hyphen penalty",
+			in:      "aaa bbb-ccc ddd",
+			optimal: NewOptimal(8).WithHyphenPenalty(0),
+			want:    []string{"aaa bbb-", "ccc ddd"},
+		},
+		{
+			name:    "consecutive hyphens",
+			in:      "aa bb-cc dd-ee ff gg",
+			optimal: NewOptimal(6).WithHyphenPenalty(0).WithConsecutiveHyphenDemerits(0),
+			want:    []string{"aa bb-", "cc dd-", "ee ff", "gg"},
+		},
+		{
+			name:    "consecutive hyphen demerits",
+			in:      "aa bb-cc dd-ee ff gg",
+			optimal: NewOptimal(6).WithHyphenPenalty(0).WithConsecutiveHyphenDemerits(10000),
+			want:    []string{"aa bb-", "cc", "dd-ee", "ff gg"},
+		},
+		{
+			name:    "widths",
+			in:      "aaa bbb ccc\nddd eee fff",
+			optimal: NewOptimal(3).WithWidths(3, 10),
+			want:    []string{"aaa", "bbb ccc", "ddd", "eee fff"},
+		},
+		{
+			name:    "emergency split on a narrow line only",
+			in:      "abcdefghij abcdefghij",
+			optimal: NewOptimal(5).WithWidths(5, 80),
+			want:    []string{"abcde", "fghij abcdefghij"},
+		},
+		{
+			name:    "no emergency split on a wide line",
+			in:      "aa abcdefghij",
+			optimal: NewOptimal(5).WithWidths(5, 80),
+			want:    []string{"aa", "abcdefghij"},
+		},
+		{
+			name:    "looseness",
+			in:      "aaa bbb ccc",
+			optimal: NewOptimal(20).WithLooseness(1),
+			want:    []string{"aaa bbb", "ccc"},
+		},
+		{
+			name:    "negative looseness, where not possible",
+			in:      "aaa bbb ccc",
+			optimal: NewOptimal(20).WithLooseness(-1),
+			want:    []string{"aaa bbb ccc"},
+		},
+		{
+			name:    "emergency split",
+			in:      "see abcdefghijkl x",
+			optimal: NewOptimal(5),
+			want:    []string{"see", "abcde", "fghij", "kl x"},
+		},
+		{
+			name:    "indented",
+			in:      "  aa bb",
+			optimal: NewOptimal(5),
+			want:    []string{"  aa", "bb"},
+		},
+		{
+			name:    "indent which does not fit",
+			in:      "  0000",
+			optimal: NewOptimal(5),
+			want:    []string{"0000"},
+		},
+		{
+			name:    "indented after a newline",
+			in:      "a\n  b",
+			optimal: NewOptimal(2),
+			want:    []string{"a", "b"},
+		},
+		{
+			name:    "mandatory breaks",
+			in:      "aa bb\n\ncc\n",
+			optimal: NewOptimal(10),
+			want:    []string{"aa bb", "", "cc"},
+		},
+		{
+			name:    "trailing tab",
+			in:      "hello world \t",
+			optimal: NewOptimal(11),
+			want:    []string{"hello world"},
+		},
+		{
+			name:    "zero value",
+			in:      "ab c",
+			optimal: Optimal{},
+			want:    []string{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.optimal.Wrap(tt.in)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptimal_Greedy(t *testing.T) {
+	// Every line but the last fits exactly
+	tests := []struct {
+		in    string
+		width int
+	}{
+		{"aaa bbb ccc ddd e", 7},
+		{"ab cd ef gh ij kl", 5},
+		{"日本語のテキスト", 4},
+		{"abcd efgh ij\nklmn opqr", 4},
+		{"  ab cdef\n  gh", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var want []string
+			for line := range WrapLines(tt.in, tt.width) {
+				if !line.Mandatory && line.Width != float64(tt.width) {
+					t.Fatalf("greedy line %q does not fit exactly", line.Text)
+				}
+				want = append(want, line.Text)
+			}
+			got := NewOptimal(float64(tt.width)).Wrap(tt.in)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", want) {
+				t.Fatalf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestOptimal_LongLooseness(t *testing.T) {
+	// Only line counts which looseness may reach are kept, so that this
+	// does not take quadratic time
+	s := strings.Repeat("word ", 4000)
+	want := len(NewOptimal(60).Wrap(s)) + 1
+	if got := len(NewOptimal(60).WithLooseness(1).Wrap(s)); got != want {
+		t.Fatalf("got %d lines, want %d", got, want)
+	}
+}
+
+func TestOptimal_WrapLines(t *testing.T) {
+	var got []Line
+	for line := range NewOptimal(6).WrapLines("aaa bb cc ddddd") {
+		got = append(got, line)
+	}
+	want := []Line{
+		{Text: "aaa", Width: 3, Start: 0, End: 4},
+		{Text: "bb cc", Width: 5, Start: 4, End: 10},
+		{Text: "ddddd", Width: 5, Start: 10, End: 15, Mandatory: true},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
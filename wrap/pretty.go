@@ -0,0 +1,230 @@
+package wrap
+
+import (
+	"iter"
+	"math"
+)
+
+// Pretty is greedy wrapping which avoids orphans and runts, as in CSS
+// text-wrap: pretty: where the last line of a paragraph is too short, such
+// as a single word, breaks in the last few lines of the paragraph are moved
+// earlier, so that the last line is longer, as evenly as possible, and with
+// no line wider than the widest by [Wrap]. Where that is not possible, the
+// lines are those of Wrap. A run which does not fit on a line is split as
+// by Wrap, and still begins a line, each of its pieces but the last ending
+// one.
+//
+// Only the last few lines of each paragraph, its window, are revisited, so
+// Pretty costs little more than [Wrap], unlike [Optimal].
+//
+// Pretty is a value; each With method returns a copy.
+type Pretty struct {
+	width float64
+	// minWords and minPercent are the least the last line must hold, 0 if
+	// not required
+	minWords, minPercent int
+	// window is the number of lines revisited
+	window   int
+	measurer Measurer
+}
+
+// NewPretty returns pretty wrapping to width, in terminal columns unless
+// measured otherwise, where the last line of a paragraph must hold at least
+// two words, revisiting the last four lines. A width which is not positive
+// is treated as the narrowest possible.
+func NewPretty(width float64) Pretty {
+	return Pretty{
+		width:    positive(width),
+		minWords: 2,
+		window:   4,
+	}
+}
+
+// WithMinWords returns a copy of p, where the last line of a paragraph must
+// hold at least words words, that is, segments between opportunities, or
+// the width given by [Pretty.WithMinPercent]. Zero requires no words.
+func (p Pretty) WithMinWords(words int) Pretty {
+	p.minWords = max(words, 0)
+	return p
+}
+
+// WithMinPercent returns a copy of p, where the last line of a paragraph
+// must be at least percent percent of the width, or hold the words given by
+// [Pretty.WithMinWords]. Zero, the default, requires no width.
+func (p Pretty) WithMinPercent(percent int) Pretty {
+	p.minPercent = max(percent, 0)
+	return p
+}
+
+// WithWindow returns a copy of p, which revisits the last lines of each
+// paragraph, at least two.
+func (p Pretty) WithWindow(lines int) Pretty {
+	p.window = max(lines, 2)
+	return p
+}
+
+// WithMeasurer returns a copy of p, which measures widths with m. A nil m
+// restores the default, [Columns].
+func (p Pretty) WithMeasurer(m Measurer) Pretty {
+	p.measurer = m
+	return p
+}
+
+// Wrap returns the lines of s, without trailing spaces or line terminators.
+// A line terminator at the end of s does not begin another line.
+func (p Pretty) Wrap(s string) []string {
+	return p.AppendWrap(nil, s)
+}
+
+// AppendWrap appends the lines of s, as by [Pretty.Wrap], to dst, and
+// returns the extended slice.
+func (p Pretty) AppendWrap(dst []string, s string) []string {
+	for line := range p.WrapLines(s) {
+		dst = append(dst, line.Text)
+	}
+	return dst
+}
+
+// WrapLines returns an iterator over the lines of s, as by [Pretty.Wrap].
+func (p Pretty) WrapLines(s string) iter.Seq[Line] {
+	p.width = positive(p.width)
+	return func(yield func(Line) bool) {
+		segs := segments(s, p.width, measurerOr(p.measurer), false)
+		var breaks []int
+		for len(segs) > 0 {
+			para := paragraph(segs)
+			segs = segs[len(para):]
+			para = indent(para, p.width)
+
+			breaks = p.breaks(para, breaks[:0])
+			start := 0
+			for _, end := range breaks {
+				if !yield(line(s, para[start:end])) {
+					return
+				}
+				start = end
+			}
+		}
+	}
+}
+
+// breaks appends the breaks of the lines of a paragraph to breaks, and
+// returns the extended slice.
+func (p Pretty) breaks(para []segment, breaks []int) []int {
+	breaks = fill(para, p.width, breaks)
+	n := len(breaks)
+	if n < 2 || p.satisfied(para[breaks[n-2]:]) {
+		return breaks
+	}
+
+	// No line is wider than the widest of those filled greedily
+	limit := widest(para, breaks)
+
+	// The lines of the window, from para[first:]
+	k := max(n-max(p.window, 2), 0)
+	first := 0
+	if k > 0 {
+		first = breaks[k-1]
+	}
+	window := para[first:]
+	lines := n - k
+
+	// offsets[i] is the width of window[:i], including trailing spaces
+	offsets := make([]float64, len(window)+1)
+	for i, seg := range window {
+		offsets[i+1] = offsets[i] + seg.width + seg.space
+	}
+	width := func(from, to int) float64 {
+		return offsets[to] - offsets[from] - window[to-1].space
+	}
+	// joined reports whether window[q] and window[q+1] may be on one line:
+	// as by greedy wrapping, a run which is split begins a line, and each
+	// of its pieces but the last ends one
+	joined := func(q int) bool {
+		return !window[q].emergency && !window[q+1].overflow
+	}
+
+	// costs[j][i] is the least cost of j+1 lines ending at window[i-1],
+	// the sum of the squares of their slack, and from[j][i] the start of
+	// the last of them
+	costs := make([][]float64, lines)
+	from := make([][]int, lines)
+	for j := range costs {
+		costs[j] = make([]float64, len(window)+1)
+		from[j] = make([]int, len(window)+1)
+		for i := range costs[j] {
+			costs[j][i] = math.Inf(1)
+		}
+	}
+	for j := 0; j < lines-1; j++ {
+		for i := 1; i <= len(window); i++ {
+			for q := i - 1; q >= 0; q-- {
+				w := width(q, i)
+				if w > limit || q < i-1 && !joined(q) {
+					break
+				}
+				if w <= 0 {
+					// A line of no content
+					continue
+				}
+				prev := 0.0
+				if j > 0 {
+					prev = costs[j-1][q]
+				} else if q > 0 {
+					continue
+				}
+				slack := limit - w
+				if c := prev + slack*slack; c < costs[j][i] {
+					costs[j][i] = c
+					from[j][i] = q
+				}
+			}
+		}
+	}
+
+	// The last line
+	best, start := math.Inf(1), -1
+	for q := len(window) - 1; q > 0; q-- {
+		w := width(q, len(window))
+		if w > limit || q < len(window)-1 && !joined(q) {
+			break
+		}
+		if w <= 0 {
+			// A line of no content
+			continue
+		}
+		if p.satisfied(window[q:]) && costs[lines-2][q] < best {
+			best, start = costs[lines-2][q], q
+		}
+	}
+	if start < 0 {
+		return breaks
+	}
+
+	breaks = breaks[:n-1]
+	i := start
+	for j := lines - 2; j >= 0; j-- {
+		breaks[k+j] = first + i
+		i = from[j][i]
+	}
+	return append(breaks, first+len(window))
+}
+
+// satisfied reports whether a last line of segs holds enough.
+func (p Pretty) satisfied(segs []segment) bool {
+	if p.minWords == 0 && p.minPercent == 0 {
+		return true
+	}
+	words, w := 0, 0.0
+	for i, seg := range segs {
+		if seg.width > 0 {
+			words++
+		}
+		w += seg.width
+		if i < len(segs)-1 {
+			w += seg.space
+		}
+	}
+	return p.minWords > 0 && words >= p.minWords ||
+		p.minPercent > 0 && w*100 >= float64(p.minPercent)*p.width
+}
@@ -0,0 +1,130 @@
+package wrap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/clipperhouse/uax14"
+)
+
+func TestPretty(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		pretty Pretty
+		want   []string
+	}{
+		{
+			name:   "empty",
+			in:     "",
+			pretty: NewPretty(20),
+			want:   nil,
+		},
+		{
+			name:   "fits",
+			in:     "Hello world",
+			pretty: NewPretty(20),
+			want:   []string{"Hello world"},
+		},
+		{
+			name:   "orphan",
+			in:     "aaaa bbbb cccc dddd e",
+			pretty: NewPretty(20),
+			want:   []string{"aaaa bbbb cccc", "dddd e"},
+		},
+		{
+			name:   "several lines",
+			in:     "The quick brown fox jumps over the lazy dog",
+			pretty: NewPretty(20),
+			want:   []string{"The quick brown", "fox jumps over the", "lazy dog"},
+		},
+		{
+			name:   "enough words",
+			in:     "The quick brown fox jumps over the lazy dog",
+			pretty: NewPretty(20).WithMinWords(1),
+			want:   []string{"The quick brown fox", "jumps over the lazy", "dog"},
+		},
+		{
+			name:   "percent",
+			in:     "aaaa bbbb cccc dddd e",
+			pretty: NewPretty(20).WithMinWords(0).WithMinPercent(50),
+			want:   []string{"aaaa bbbb", "cccc dddd e"},
+		},
+		{
+			name:   "window",
+			in:     "one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen sixteen seventeen eighteen nineteen twenty x",
+			pretty: NewPretty(20).WithMinWords(0).WithMinPercent(50).WithWindow(2),
+			want:   []string{"one two three four", "five six seven eight", "nine ten eleven", "twelve thirteen", "fourteen fifteen", "sixteen seventeen", "eighteen", "nineteen twenty x"},
+		},
+		{
+			name:   "not possible",
+			in:     "abcdefghijklmnopqrst x",
+			pretty: NewPretty(20),
+			want:   []string{"abcdefghijklmnopqrst", "x"},
+		},
+		{
+			name:   "paragraphs",
+			in:     "aaaa bbbb cccc dddd e\nf",
+			pretty: NewPretty(20),
+			want:   []string{"aaaa bbbb cccc", "dddd e", "f"},
+		},
+		{
+			name:   "indented",
+			in:     "  aa bb",
+			pretty: NewPretty(5),
+			want:   []string{"  aa", "bb"},
+		},
+		{
+			name:   "emergency split",
+			in:     "000000!00 !",
+			pretty: NewPretty(3),
+			want:   []string{"000", "000", "!", "00", "!"},
+		},
+		{
+			name:   "emergency split, revisited",
+			in:     "aa bbbbbb cc dd",
+			pretty: NewPretty(5),
+			want:   []string{"aa", "bbbbb", "b", "cc dd"},
+		},
+		{
+			name:   "white space alone at the start of the last line",
+			in:     "aa \t\u4E2D",
+			pretty: NewPretty(1),
+			want:   []string{"a", "a", "\u4E2D"},
+		},
+		{
+			name:   "trailing tab",
+			in:     "hello world \t",
+			pretty: NewPretty(11),
+			want:   []string{"hello world"},
+		},
+		{
+			name:   "zero value",
+			in:     "ab c",
+			pretty: Pretty{},
+			want:   []string{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.pretty.Wrap(tt.in)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			greedy := NewGreedy(tt.pretty.width).Wrap(tt.in)
+			if len(got) != len(greedy) {
+				t.Fatalf("got %d lines, want %d, as by Wrap", len(got), len(greedy))
+			}
+			widest := 0
+			for _, line := range greedy {
+				widest = max(widest, uax14.Width(line))
+			}
+			for _, line := range got {
+				if w := uax14.Width(line); w > widest {
+					t.Fatalf("line %q is %d columns wide, wider than by Wrap", line, w)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package wrap
+
+import "github.com/clipperhouse/uax14"
+
+// segment is the text between two breaks, for wrapping which considers all
+// of the opportunities of a paragraph at once.
+type segment struct {
+	// start, content and end are byte positions: content is the end of
+	// the segment without trailing spaces and any line terminator
+	start, content, end int
+	// width is the width of the content, and space of the trailing spaces
+	width, space float64
+	// hyphen is true after a hyphen, or at a hyphenated break
+	hyphen bool
+	// emergency is true at a break within an unbreakable run, which ends a
+	// line in greedy wrapping
+	emergency bool
+	// overflow is true for the first piece of an unbreakable run which is
+	// split, which begins a line in greedy wrapping
+	overflow bool
+	// mandatory is true at the end of a paragraph
+	mandatory bool
+}
+
+// segments returns the segments of s, measured by m. Runs which are wider
+// than width are split at grapheme cluster boundaries, as by
+// [uax14.Iterator.Overflow]: into the same pieces as by [Greedy], or, if
+// byCluster, between every cluster, so that the caller may choose among
+// those breaks.
+func segments(s string, width float64, m Measurer, byCluster bool) []segment {
+	var segs []segment
+	iter := uax14.NewIterator(s)
+	fits := func(segment string) bool {
+		return m.Measure(trimSpace(segment)) <= width
+	}
+	never := func(string) bool {
+		return false
+	}
+	for iter.Next() {
+		// Within a run which is split, after an emergency break
+		within := len(segs) > 0 && segs[len(segs)-1].emergency
+		overflow := false
+		switch {
+		case byCluster:
+			if within || measureUpTo(m, trimSpace(iter.Current()), width) > width {
+				iter.Overflow(never)
+			}
+		case measureUpTo(m, trimSpace(iter.Current()), width) > width:
+			overflow = iter.Overflow(fits) && !within
+		}
+		current := iter.Current()
+		content := trimSpace(current)
+		if last := len(segs) - 1; content == "" && last >= 0 && !segs[last].mandatory {
+			// White space alone, such as a tab after a space, is trailing
+			// space of the segment before it
+			segs[last].end = iter.End()
+			segs[last].space += m.Measure(current)
+			segs[last].mandatory = iter.MustBreak()
+			continue
+		}
+		segs = append(segs, segment{
+			start:     iter.Start(),
+			content:   iter.Start() + len(content),
+			end:       iter.End(),
+			width:     m.Measure(content),
+			space:     m.Measure(current[len(content):]),
+			hyphen:    iter.Hyphenated() || iter.AfterHyphen(),
+			emergency: iter.EmergencyBreak(),
+			overflow:  overflow,
+			mandatory: iter.MustBreak(),
+		})
+	}
+	return segs
+}
+
+// paragraph returns the segments of the paragraph at the start of segs,
+// up to and including the first mandatory break.
+func paragraph(segs []segment) []segment {
+	for i, seg := range segs {
+		if seg.mandatory {
+			return segs[:i+1]
+		}
+	}
+	return segs
+}
+
+// indent returns a paragraph without a segment of leading spaces alone,
+// which would otherwise end a line of no content. As in greedy wrapping,
+// the spaces begin the first segment with content, where they fit on a
+// line of width with it, and are dropped otherwise.
+func indent(para []segment, width float64) []segment {
+	if len(para) < 2 || para[0].content > para[0].start || para[0].mandatory {
+		return para
+	}
+	if para[0].space+para[1].width <= width {
+		para[1].start = para[0].start
+		para[1].width += para[0].space
+	}
+	return para[1:]
+}
+
+// line returns the line of s made of segs.
+func line(s string, segs []segment) Line {
+	last, w := measureLine(segs)
+	return Line{
+		Text:      s[segs[0].start:segs[last].content],
+		Width:     w,
+		Start:     segs[0].start,
+		End:       segs[len(segs)-1].end,
+		Mandatory: segs[len(segs)-1].mandatory,
+	}
+}
+
+// measureLine returns the index of the last of segs with content, at which
+// the text of their line ends, and the width of that text. Segments of
+// white space alone after it are trailing spaces.
+func measureLine(segs []segment) (last int, width float64) {
+	last = len(segs) - 1
+	for last > 0 && segs[last].content == segs[last].start {
+		last--
+	}
+	for _, seg := range segs[:last] {
+		width += seg.width + seg.space
+	}
+	return last, width + segs[last].width
+}
@@ -0,0 +1,187 @@
+// Package wrap wraps text to a width, at the line break opportunities of
+// UAX #14.
+//
+// [Greedy] fills lines in turn: each line takes as many segments as fit.
+// [Optimal] chooses the breaks of each paragraph together, for a less ragged
+// margin; [Balanced] makes the lines of each paragraph of about the same
+// width; and [Pretty] avoids a short last line. Line terminators, such as
+// newlines, always end a line. A run of text which has no opportunity and
+// does not fit on a line by itself, such as a long URL, is split between
+// grapheme clusters.
+//
+// Widths are in terminal columns, unless measured by another [Measurer],
+// such as one for a proportional font. [Wrap] and [Balance] are shorthands
+// for wrapping to a number of columns.
+package wrap
+
+import (
+	"iter"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/uax14"
+)
+
+// Line is a line of wrapped text.
+type Line struct {
+	// Text is the line, without trailing spaces or line terminator.
+	Text string
+	// Width is the width of Text, the sum of the widths of its segments and
+	// the spaces between them.
+	Width float64
+	// Start and End are the byte positions of the line in the original
+	// text, including trailing spaces and any line terminator.
+	Start, End int
+	// Mandatory is true if the line ends at a mandatory break, such as after
+	// a line terminator, or at the end of the text.
+	Mandatory bool
+}
+
+// Wrap returns the lines of s, wrapped greedily to width in terminal
+// columns, without trailing spaces or line terminators. A line terminator at
+// the end of s does not begin another line. It is shorthand for
+// NewGreedy(float64(width)).Wrap(s).
+func Wrap(s string, width int) []string {
+	return AppendWrap(nil, s, width)
+}
+
+// AppendWrap appends the lines of s, wrapped to width as by [Wrap], to dst,
+// and returns the extended slice.
+func AppendWrap(dst []string, s string, width int) []string {
+	return NewGreedy(float64(width)).AppendWrap(dst, s)
+}
+
+// WrapLines returns an iterator over the lines of s, wrapped to width as by
+// [Wrap].
+func WrapLines(s string, width int) iter.Seq[Line] {
+	return NewGreedy(float64(width)).WrapLines(s)
+}
+
+// Greedy is greedy wrapping, which fills each line in turn with as many
+// segments as fit.
+//
+// Greedy is a value; each With method returns a copy.
+type Greedy struct {
+	width    float64
+	measurer Measurer
+}
+
+// NewGreedy returns greedy wrapping to width, in terminal columns unless
+// measured otherwise. A width which is not positive is treated as the
+// narrowest possible, so that each line holds one grapheme cluster.
+func NewGreedy(width float64) Greedy {
+	return Greedy{width: positive(width)}
+}
+
+// WithMeasurer returns a copy of g, which measures widths with m. A nil m
+// restores the default, [Columns].
+func (g Greedy) WithMeasurer(m Measurer) Greedy {
+	g.measurer = m
+	return g
+}
+
+// Wrap returns the lines of s, without trailing spaces or line terminators.
+// A line terminator at the end of s does not begin another line.
+func (g Greedy) Wrap(s string) []string {
+	return g.AppendWrap(nil, s)
+}
+
+// AppendWrap appends the lines of s, as by [Greedy.Wrap], to dst, and
+// returns the extended slice.
+func (g Greedy) AppendWrap(dst []string, s string) []string {
+	for line := range g.WrapLines(s) {
+		dst = append(dst, line.Text)
+	}
+	return dst
+}
+
+// WrapLines returns an iterator over the lines of s, as by [Greedy.Wrap].
+func (g Greedy) WrapLines(s string) iter.Seq[Line] {
+	width := positive(g.width)
+	m := measurerOr(g.measurer)
+	return func(yield func(Line) bool) {
+		segments := uax14.NewIterator(s)
+		fits := func(segment string) bool {
+			return m.Measure(trimSpace(segment)) <= width
+		}
+
+		var line Line
+		pending := 0.0 // width of the spaces after line.Text
+		full := false  // line ended at an emergency break
+		for segments.Next() {
+			segment := segments.Current()
+			content := trimSpace(segment)
+			w := measureUpTo(m, content, width)
+
+			if content == "" && line.Text != "" {
+				// White space alone, such as a tab after a space, hangs
+				// past the margin, as trailing spaces do
+				line.End = segments.End()
+				pending += m.Measure(segment)
+			} else {
+				if full || segments.Start() > line.Start && line.Width+pending+w > width {
+					// Leading spaces, alone, are dropped
+					if line.Text != "" && !yield(line) {
+						return
+					}
+					line = Line{Start: segments.Start()}
+					pending = 0
+					full = false
+				}
+				if segments.Start() == line.Start && w > width {
+					if segments.Overflow(fits) {
+						segment = segments.Current()
+						content = trimSpace(segment)
+					}
+					w = m.Measure(content)
+				}
+
+				line.Width += pending + w
+				line.Text = s[line.Start : segments.Start()+len(content)]
+				line.End = segments.End()
+				pending = m.Measure(segment[len(content):])
+			}
+
+			if segments.EmergencyBreak() && !segments.MustBreak() {
+				// The line is ended by the next segment with content, so
+				// that white space alone hangs on this one
+				full = true
+			} else if segments.MustBreak() {
+				line.Mandatory = true
+				if !yield(line) {
+					return
+				}
+				line = Line{Start: segments.End()}
+				pending = 0
+				full = false
+			}
+		}
+	}
+}
+
+// trimSpace returns segment without trailing spaces and line terminators:
+// characters of the classes SP, BK, CR, LF, NL and ZW, and white space of
+// class BA, such as tab. No-break spaces, of class GL, are kept.
+func trimSpace(segment string) string {
+	for len(segment) > 0 {
+		r, w := utf8.DecodeLastRuneInString(segment)
+		if !isBreakingSpace(r) {
+			break
+		}
+		segment = segment[:len(segment)-w]
+	}
+	return segment
+}
+
+// isBreakingSpace reports whether r is white space which a line may end
+// at: any but U+00A0 NO-BREAK SPACE, U+2007 FIGURE SPACE and U+202F NARROW
+// NO-BREAK SPACE, of class GL; or U+200B ZERO WIDTH SPACE, of class ZW.
+func isBreakingSpace(r rune) bool {
+	switch r {
+	case '\u00A0', '\u2007', '\u202F':
+		return false
+	case '\u200B':
+		return true
+	}
+	return unicode.IsSpace(r)
+}
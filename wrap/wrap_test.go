@@ -0,0 +1,210 @@
+package wrap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/uax14"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  []string
+	}{
+		{
+			name:  "empty",
+			in:    "",
+			width: 10,
+			want:  nil,
+		},
+		{
+			name:  "fits",
+			in:    "hello world",
+			width: 11,
+			want:  []string{"hello world"},
+		},
+		{
+			name:  "words",
+			in:    "the quick brown fox jumps over the lazy dog",
+			width: 10,
+			want:  []string{"the quick", "brown fox", "jumps over", "the lazy", "dog"},
+		},
+		{
+			name:  "trailing spaces do not count",
+			in:    "abcde     fgh",
+			width: 5,
+			want:  []string{"abcde", "fgh"},
+		},
+		{
+			name:  "hyphens",
+			in:    "a well-known fact",
+			width: 7,
+			want:  []string{"a well-", "known", "fact"},
+		},
+		{
+			name:  "mandatory breaks",
+			in:    "one\ntwo three\r\n\nfour\n",
+			width: 20,
+			want:  []string{"one", "two three", "", "four"},
+		},
+		{
+			name:  "wide characters",
+			in:    "日本語のテキスト",
+			width: 5,
+			want:  []string{"日本", "語の", "テキ", "スト"},
+		},
+		{
+			name:  "combining marks",
+			in:    "e\u0301e\u0301e\u0301 e\u0301",
+			width: 4,
+			want:  []string{"e\u0301e\u0301e\u0301", "e\u0301"},
+		},
+		{
+			name:  "emergency split",
+			in:    "see https://example.com/a/very/long/path now",
+			width: 10,
+			want:  []string{"see", "https://", "example.co", "m/a/very/", "long/path", "now"},
+		},
+		{
+			name:  "emergency split of wide characters",
+			in:    "ａｂｃ",
+			width: 3,
+			want:  []string{"ａ", "ｂ", "ｃ"},
+		},
+		{
+			name:  "emergency split keeps clusters",
+			in:    "🇯🇵🇯🇵",
+			width: 1,
+			want:  []string{"🇯🇵", "🇯🇵"},
+		},
+		{
+			name:  "leading spaces",
+			in:    "  indented text",
+			width: 20,
+			want:  []string{"  indented text"},
+		},
+		{
+			name:  "leading spaces which do not fit",
+			in:    "      abcd",
+			width: 5,
+			want:  []string{"abcd"},
+		},
+		{
+			name:  "no-break spaces are kept",
+			in:    "a\u00A0 b\u202F",
+			width: 5,
+			want:  []string{"a\u00A0 b\u202F"},
+		},
+		{
+			name:  "no-break space at a break",
+			in:    "aaa\u00A0 bbb",
+			width: 4,
+			want:  []string{"aaa\u00A0", "bbb"},
+		},
+		{
+			name:  "trailing tab",
+			in:    "hello world \t",
+			width: 11,
+			want:  []string{"hello world"},
+		},
+		{
+			name:  "white space alone after an emergency break",
+			in:    "00 !",
+			width: 0,
+			want:  []string{"0", "0", "!"},
+		},
+		{
+			name:  "invalid UTF-8",
+			in:    "\xff",
+			width: 0,
+			want:  []string{"\xff"},
+		},
+		{
+			name:  "emergency split of invalid UTF-8",
+			in:    "ab\xff\xffcd",
+			width: 2,
+			want:  []string{"ab", "\xff\xff", "cd"},
+		},
+		{
+			name:  "zero width",
+			in:    "ab c",
+			width: 0,
+			want:  []string{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Wrap(tt.in, tt.width)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			for _, line := range got {
+				if w := uax14.Width(line); w > max(tt.width, 1) && strings.ContainsRune(tt.in, ' ') {
+					t.Fatalf("line %q is %d columns wide", line, w)
+				}
+			}
+		})
+	}
+}
+
+func TestWrap_InvalidUTF8(t *testing.T) {
+	// Every wrapping splits runs ending in invalid UTF-8
+	wrappers := []struct {
+		name string
+		wrap interface{ Wrap(string) []string }
+	}{
+		{"greedy", NewGreedy(0)},
+		{"optimal", NewOptimal(5)},
+		{"balanced", NewBalanced(0)},
+		{"pretty", NewPretty(0)},
+	}
+	for _, in := range []string{"\xff", "a\xff", "\u0301dddddddd\xff", "ab \xff\xfe\xfd cd"} {
+		for _, w := range wrappers {
+			got := w.wrap.Wrap(in)
+			if len(got) == 0 {
+				t.Errorf("%s: %q has no lines", w.name, in)
+			}
+		}
+	}
+}
+
+func TestAppendWrap(t *testing.T) {
+	dst := []string{"first"}
+	got := AppendWrap(dst, "a b", 1)
+	want := []string{"first", "a", "b"}
+	if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestWrapLines(t *testing.T) {
+	in := "ab cd\n日本"
+	var got []Line
+	for line := range WrapLines(in, 3) {
+		got = append(got, line)
+	}
+	want := []Line{
+		{Text: "ab", Width: 2, Start: 0, End: 3},
+		{Text: "cd", Width: 2, Start: 3, End: 6, Mandatory: true},
+		{Text: "日", Width: 2, Start: 6, End: 9},
+		{Text: "本", Width: 2, Start: 9, End: 12, Mandatory: true},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	// Stopping early
+	n := 0
+	for range WrapLines(in, 3) {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Fatalf("got %d lines, want 1", n)
+	}
+}